@@ -8,7 +8,7 @@ ARRAY=( "linux:amd64"
 for kv in "${ARRAY[@]}" ; do
     KEY=${kv%%:*}
     VALUE=${kv#*:}
-    env GOOS=$KEY GOARCH=$VALUE go build -o jam -ldflags "-s -w" cmd/client/main.go 
+    env GOOS=$KEY GOARCH=$VALUE go build -o jam -ldflags "-s -w" ./cmd/client 
 
     if [[ "$KEY" == "darwin" ]]
     then
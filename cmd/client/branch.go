@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+)
+
+// mainBranch is the branch that every project starts out with.
+const mainBranch = "main"
+
+func runBranch(args []string) error {
+	flags := newFlagSet("branch")
+	from := flags.String("from", "", "branch to fork the new branch from, defaults to the current branch")
+	positional, err := parseFlags(flags, args, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if len(positional) == 0 {
+		return s.printBranches()
+	}
+
+	parent := *from
+	if parent == "" {
+		parent = s.remote.GetBranch()
+	}
+	branch, err := s.api.CreateBranch(context.Background(), &pb.CreateBranchRequest{
+		ProjectId: s.remote.GetProjectId(),
+		Name:      positional[0],
+		Parent:    parent,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Created branch %s from %s at change %d\n", branch.GetName(), branch.GetParent(), branch.GetForkChangeId())
+	return s.switchBranch(branch.GetName())
+}
+
+func (s *session) printBranches() error {
+	resp, err := s.api.ListBranches(context.Background(), &pb.ListBranchesRequest{
+		ProjectId: s.remote.GetProjectId(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, branch := range resp.GetBranches() {
+		marker := " "
+		if branch.GetName() == s.remote.GetBranch() {
+			marker = "*"
+		}
+		if branch.GetParent() == "" {
+			fmt.Printf("%s %s at change %d\n", marker, branch.GetName(), branch.GetHeadChangeId())
+		} else {
+			fmt.Printf("%s %s at change %d, forked from %s at change %d\n", marker, branch.GetName(), branch.GetHeadChangeId(), branch.GetParent(), branch.GetForkChangeId())
+		}
+	}
+	return nil
+}
+
+func runSwitch(args []string) error {
+	positional, err := parseFlags(newFlagSet("switch"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if positional[0] == s.remote.GetBranch() {
+		log.Printf("Already on branch %s.\n", positional[0])
+		return nil
+	}
+	return s.switchBranch(positional[0])
+}
+
+// switchBranch moves the local directory to the head of branch. Local changes are
+// kept the same way a pull keeps them, and are pushed to branch from then on.
+func (s *session) switchBranch(branch string) error {
+	previous := s.client.ProjectConfig().GetBranch()
+	s.client.SetBranch(branch)
+	err := s.refreshRemote()
+	if err != nil {
+		s.client.SetBranch(previous)
+		return err
+	}
+
+	if s.behind() {
+		err = s.pull()
+	} else {
+		err = writeJamsyncFile(s.client.ProjectConfig())
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("Switched to branch %s at change %d\n", branch, s.remote.GetCurrentChange())
+	return nil
+}
+
+func runMerge(args []string) error {
+	flags := newFlagSet("merge")
+	message := flags.String("m", "", "message describing the change")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	resp, err := s.api.MergeBranch(context.Background(), &pb.MergeBranchRequest{
+		ProjectId: s.remote.GetProjectId(),
+		Name:      positional[0],
+		Message:   *message,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Merged branch %s in change %d\n", positional[0], resp.GetChangeId())
+
+	// Pull the merge if the local directory is on the branch that was merged into.
+	err = s.refreshRemote()
+	if err != nil {
+		return err
+	}
+	if s.behind() {
+		return s.pull()
+	}
+	return nil
+}
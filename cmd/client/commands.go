@@ -0,0 +1,879 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/jamignore"
+	jam "github.com/zdgeier/jamsync/internal/server/client"
+)
+
+// session is a local project directory connected to the server. client is always
+// at the change that the local directory was last synced with.
+type session struct {
+	api     pb.JamsyncAPIClient
+	client  *jam.Client
+	remote  *pb.ProjectConfig
+	ignorer *jamignore.Matcher
+	closer  func()
+}
+
+func openSession() (*session, error) {
+	config, err := openProject()
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteConfig, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
+		ProjectId: config.GetProjectId(),
+		Branch:    config.GetBranch(),
+	})
+	if err != nil {
+		closer()
+		return nil, err
+	}
+
+	client := jam.NewClient(apiClient, config.GetProjectId(), config.GetCurrentChange())
+	client.SetBranch(remoteConfig.GetBranch())
+	return &session{
+		api:     apiClient,
+		client:  client,
+		remote:  remoteConfig,
+		ignorer: newIgnorer(remoteConfig),
+		closer:  closer,
+	}, nil
+}
+
+// newClient returns a client at changeId of the branch that the local directory is
+// on.
+func (s *session) newClient(changeId uint64) *jam.Client {
+	client := jam.NewClient(s.api, s.remote.GetProjectId(), changeId)
+	client.SetBranch(s.client.ProjectConfig().GetBranch())
+	return client
+}
+
+// behind reports whether the project has changes that the local directory does not.
+func (s *session) behind() bool {
+	return s.client.ProjectConfig().GetCurrentChange() != s.remote.GetCurrentChange()
+}
+
+func (s *session) refreshRemote() error {
+	remoteConfig, err := s.api.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
+		ProjectId: s.client.ProjectConfig().GetProjectId(),
+		Branch:    s.client.ProjectConfig().GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+	s.remote = remoteConfig
+	return nil
+}
+
+// pushAttempts is the number of times push pulls and tries again when its commit
+// conflicts with a change that was committed while it was uploading.
+const pushAttempts = 3
+
+// push uploads local changes, if there are any, and reports whether it did. Remote
+// changes committed in the meantime are pulled first, so they are never overwritten.
+func (s *session) push(message string) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		pushed, err := s.pushOnce(message)
+		conflict, isConflict := jam.CommitConflict(err)
+		if !isConflict || attempt == pushAttempts {
+			return pushed, err
+		}
+		log.Printf("Change %d also changed %s, pulling it first\n", conflict.GetLatestChangeId(), strings.Join(conflict.GetPaths(), ", "))
+		err = s.pull()
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+func (s *session) pushOnce(message string) (bool, error) {
+	fileMetadata := readLocalFileList(s.ignorer)
+	localToRemoteDiff, err := s.client.DiffLocalToRemote(context.Background(), fileMetadata)
+	if err != nil {
+		return false, err
+	}
+	if !diffHasChanges(localToRemoteDiff) {
+		return false, nil
+	}
+	// Conflict markers pushed by accident would end up in every collaborator's copy.
+	conflicted, err := conflictedFiles(localToRemoteDiff)
+	if err != nil {
+		return false, err
+	}
+	if len(conflicted) > 0 {
+		return false, fmt.Errorf("%w in %s, resolve them to push", errUnresolvedConflicts, strings.Join(conflicted, ", "))
+	}
+
+	err = pushFileListDiff(fileMetadata, localToRemoteDiff, s.client, message)
+	if err != nil {
+		return false, err
+	}
+	return true, writeJamsyncFile(s.client.ProjectConfig())
+}
+
+// pull brings the local directory up to the latest remote change.
+func (s *session) pull() error {
+	plan, err := s.planPull()
+	if err != nil {
+		return err
+	}
+	return s.applyPull(plan)
+}
+
+func (s *session) applyPull(plan *pullPlan) error {
+	logKept(plan.kept)
+
+	baseClient := s.client
+	s.client = plan.client
+	return pull(baseClient, s.client, plan.localToRemoteDiff, plan.remoteToLocalDiff, plan.deletes)
+}
+
+// pullPlan is what moving to a remote change would do to the local directory.
+type pullPlan struct {
+	client            *jam.Client
+	localToRemoteDiff *pb.FileMetadataDiff
+	remoteToLocalDiff *pb.FileMetadataDiff
+	deletes           []string
+	kept              []string
+}
+
+func (s *session) planPull() (*pullPlan, error) {
+	err := s.refreshRemote()
+	if err != nil {
+		return nil, err
+	}
+	return s.planPullTo(s.remote.GetCurrentChange())
+}
+
+// planPullTo plans moving the local directory to changeId, which doesn't have to be
+// the latest remote change.
+func (s *session) planPullTo(changeId uint64) (*pullPlan, error) {
+	ctx := context.Background()
+	fileMetadata := readLocalFileList(s.ignorer)
+	localToRemoteDiff, err := s.client.DiffLocalToRemote(ctx, fileMetadata)
+	if err != nil {
+		return nil, err
+	}
+	baseFileMetadata, err := s.client.FileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s.newClient(changeId)
+	remoteToLocalDiff, err := client.DiffRemoteToLocal(ctx, fileMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	deletes, kept := planDeletes(baseFileMetadata, fileMetadata, remoteToLocalDiff)
+	return &pullPlan{
+		client:            client,
+		localToRemoteDiff: localToRemoteDiff,
+		remoteToLocalDiff: remoteToLocalDiff,
+		deletes:           deletes,
+		kept:              kept,
+	}, nil
+}
+
+func (p *pullPlan) print() {
+	downloads := make([]string, 0)
+	for path, diff := range p.remoteToLocalDiff.GetDiffs() {
+		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetType() != pb.FileMetadataDiff_Delete && !diff.GetFile().GetDir() {
+			downloads = append(downloads, path)
+		}
+	}
+	sort.Strings(downloads)
+
+	for _, path := range downloads {
+		fmt.Println("  download:", path)
+	}
+	for _, path := range p.deletes {
+		fmt.Println("  delete:  ", path)
+	}
+	for _, path := range p.kept {
+		fmt.Println("  keep:    ", path, "(deleted remotely but changed locally)")
+	}
+}
+
+func runInit(args []string) error {
+	flags := newFlagSet("init")
+	name := flags.String("name", "", "name of the project to create, defaults to the name of the current directory")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	config, root, err := findJamsyncConfig()
+	if err != nil {
+		return err
+	}
+	if config != nil {
+		return fmt.Errorf("%s is already a jamsync project", root)
+	}
+
+	currentPath, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	projectName := *name
+	if projectName == "" {
+		projectName = filepath.Base(currentPath)
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := apiClient.AddProject(context.Background(), &pb.AddProjectRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return err
+	}
+	log.Println("Initializing project " + projectName + " at " + currentPath)
+
+	newConfig, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
+		ProjectId: resp.GetProjectId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	client := jam.NewClient(apiClient, resp.GetProjectId(), 0)
+	return uploadNewProject(client, newIgnorer(newConfig))
+}
+
+func runClone(args []string) error {
+	flags := newFlagSet("clone")
+	branch := flags.String("branch", "", "branch to download, defaults to the main branch")
+	positional, err := parseFlags(flags, args, 1, 2)
+	if err != nil {
+		return err
+	}
+	projectName := positional[0]
+	directory := projectName
+	if len(positional) == 2 {
+		directory = positional[1]
+	}
+
+	empty, err := directoryEmpty(directory)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return fmt.Errorf("destination %s already exists and is not empty", directory)
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
+		ProjectName: projectName,
+		Branch:      *branch,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = os.Chdir(directory)
+	if err != nil {
+		return err
+	}
+
+	client := jam.NewClient(apiClient, resp.GetProjectId(), resp.GetCurrentChange())
+	client.SetBranch(resp.GetBranch())
+	diffRemoteToLocalResp, err := client.DiffRemoteToLocal(context.Background(), &pb.FileMetadata{})
+	if err != nil {
+		return err
+	}
+
+	err = applyFileListDiff(diffRemoteToLocalResp, client)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Done downloading.")
+	return writeJamsyncFile(client.ProjectConfig())
+}
+
+func runPush(args []string) error {
+	flags := newFlagSet("push")
+	message := flags.String("m", "", "message describing the change")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if s.behind() {
+		return errors.New("the project has remote changes that are not local yet, run jam pull first")
+	}
+
+	pushed, err := s.push(*message)
+	if err != nil {
+		return err
+	}
+	if !pushed {
+		log.Println("Everything up to date.")
+	}
+	return nil
+}
+
+func runPull(args []string) error {
+	flags := newFlagSet("pull")
+	dryRun := flags.Bool("dry-run", false, "list what would be downloaded and deleted without changing anything")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if !s.behind() {
+		log.Println("Already up to date.")
+		return nil
+	}
+	if *dryRun {
+		plan, err := s.planPull()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Pulling change %d would:\n", s.remote.GetCurrentChange())
+		plan.print()
+		return nil
+	}
+	return s.pull()
+}
+
+func runStatus(args []string) error {
+	if _, err := parseFlags(newFlagSet("status"), args, 0, 0); err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	st, err := s.status()
+	if err != nil {
+		return err
+	}
+	st.print(s)
+	return nil
+}
+
+func runLog(args []string) error {
+	flags := newFlagSet("log")
+	count := flags.Int("n", 0, "maximum number of changes to list, 0 lists all of them")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	config, err := openProject()
+	if err != nil {
+		return err
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	printed := 0
+	var pageToken uint64
+	for {
+		resp, err := apiClient.ListChanges(context.Background(), &pb.ListChangesRequest{
+			ProjectId: config.GetProjectId(),
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, change := range resp.GetChanges() {
+			if !change.GetCommitted() {
+				continue
+			}
+			if *count > 0 && printed >= *count {
+				return nil
+			}
+			printChange(change)
+			printed++
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if pageToken == 0 {
+			return nil
+		}
+	}
+}
+
+func printChange(change *pb.ChangeInfo) {
+	fmt.Printf("change %d\n", change.GetChangeId())
+	if change.GetBranch() != mainBranch {
+		fmt.Printf("Branch: %s\n", change.GetBranch())
+	}
+	if change.GetHostname() != "" {
+		fmt.Printf("Author: %s on %s\n", change.GetAuthorId(), change.GetHostname())
+	} else {
+		fmt.Printf("Author: %s\n", change.GetAuthorId())
+	}
+	fmt.Printf("Date:   %s\n", change.GetCommitTimestamp().AsTime().Local().Format(time.RFC1123))
+	if change.GetMessage() != "" {
+		fmt.Printf("\n    %s\n", change.GetMessage())
+	}
+	fmt.Println()
+	for _, file := range change.GetFiles() {
+		if file.GetPath() != "" && file.GetPath() != ".jamsyncfilelist" {
+			fmt.Println("  ", file.GetPath())
+		}
+	}
+	fmt.Println()
+}
+
+func runRestore(args []string) error {
+	flags := newFlagSet("restore")
+	changeId := flags.Uint64("change", 0, "change to restore the path to")
+	message := flags.String("m", "", "message describing the change")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *changeId == 0 {
+		return usageError{"a change to restore to is required"}
+	}
+
+	// Paths are given relative to the current directory but stored relative to the
+	// project root, which openSession changes to.
+	absPath, err := filepath.Abs(positional[0])
+	if err != nil {
+		return err
+	}
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	path, err := rootRelative(absPath)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.api.RestoreFile(context.Background(), &pb.RestoreFileRequest{
+		ProjectId: s.remote.GetProjectId(),
+		Path:      path,
+		ChangeId:  *changeId,
+		Message:   *message,
+		Branch:    s.remote.GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Restored %s to change %d in change %d\n", path, *changeId, resp.GetChangeId())
+	return s.pull()
+}
+
+// rootRelative returns absPath relative to the project root, which must be the
+// current directory, in the slash separated form used for project paths.
+func rootRelative(absPath string) (string, error) {
+	root, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	path, err := filepath.Rel(root, absPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(path), nil
+}
+
+func runDiff(args []string) error {
+	flags := newFlagSet("diff")
+	from := flags.Uint64("from", 0, "change to compare from, defaults to the local change")
+	to := flags.Uint64("to", 0, "change to compare to, defaults to the head of the local branch")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	absPath, err := filepath.Abs(positional[0])
+	if err != nil {
+		return err
+	}
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	path, err := rootRelative(absPath)
+	if err != nil {
+		return err
+	}
+
+	fromChange := *from
+	if fromChange == 0 {
+		fromChange = s.client.ProjectConfig().GetCurrentChange()
+	}
+	resp, err := s.api.DiffFile(context.Background(), &pb.DiffFileRequest{
+		ProjectId:  s.remote.GetProjectId(),
+		Path:       path,
+		FromChange: fromChange,
+		ToChange:   *to,
+		Branch:     s.client.ProjectConfig().GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.GetChanged() {
+		log.Println("No changes to", path)
+		return nil
+	}
+	fmt.Print(resp.GetDiff())
+	return nil
+}
+
+func runRevert(args []string) error {
+	flags := newFlagSet("revert")
+	message := flags.String("m", "", "message describing the change")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	changeId, err := strconv.ParseUint(positional[0], 10, 64)
+	if err != nil {
+		return usageError{fmt.Sprintf("invalid change %q", positional[0])}
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	resp, err := s.api.RevertChange(context.Background(), &pb.RevertChangeRequest{
+		ProjectId: s.remote.GetProjectId(),
+		ChangeId:  changeId,
+		Message:   *message,
+		Branch:    s.remote.GetBranch(),
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Reverted project to change %d in change %d\n", changeId, resp.GetChangeId())
+	return s.pull()
+}
+
+func runProjects(args []string) error {
+	flags := newFlagSet("projects")
+	archived := flags.Bool("archived", false, "also list archived projects")
+	if _, err := parseFlags(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	resp, err := apiClient.ListUserProjects(context.Background(), &pb.ListUserProjectsRequest{
+		IncludeArchived: *archived,
+	})
+	if err != nil {
+		return err
+	}
+	for _, project := range resp.GetProjects() {
+		if project.GetArchived() {
+			fmt.Println(project.GetName(), "(archived)")
+		} else {
+			fmt.Println(project.GetName())
+		}
+	}
+	return nil
+}
+
+// lookupProject returns the id of a project by name.
+func lookupProject(apiClient pb.JamsyncAPIClient, projectName string) (uint64, error) {
+	resp, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
+		ProjectName: projectName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetProjectId(), nil
+}
+
+func runRename(args []string) error {
+	positional, err := parseFlags(newFlagSet("rename"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	projectId, err := lookupProject(apiClient, positional[0])
+	if err != nil {
+		return err
+	}
+	_, err = apiClient.RenameProject(context.Background(), &pb.RenameProjectRequest{
+		ProjectId:   projectId,
+		ProjectName: positional[1],
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Renamed project %s to %s\n", positional[0], positional[1])
+	return nil
+}
+
+func runArchive(args []string) error {
+	flags := newFlagSet("archive")
+	undo := flags.Bool("undo", false, "unarchive the project")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	projectId, err := lookupProject(apiClient, positional[0])
+	if err != nil {
+		return err
+	}
+	_, err = apiClient.ArchiveProject(context.Background(), &pb.ArchiveProjectRequest{
+		ProjectId: projectId,
+		Archived:  !*undo,
+	})
+	if err != nil {
+		return err
+	}
+	if *undo {
+		log.Printf("Unarchived project %s\n", positional[0])
+	} else {
+		log.Printf("Archived project %s\n", positional[0])
+	}
+	return nil
+}
+
+func runDeleteProject(args []string) error {
+	flags := newFlagSet("delete-project")
+	yes := flags.Bool("yes", false, "confirm that the project should be deleted")
+	positional, err := parseFlags(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if !*yes {
+		return usageError{"deleting a project can't be undone, pass -yes to confirm"}
+	}
+
+	apiClient, closer, err := connect()
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	projectId, err := lookupProject(apiClient, positional[0])
+	if err != nil {
+		return err
+	}
+	_, err = apiClient.DeleteProject(context.Background(), &pb.DeleteProjectRequest{
+		ProjectId: projectId,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Deleted project %s\n", positional[0])
+	return nil
+}
+
+func runWatch(args []string) error {
+	if _, err := parseFlags(newFlagSet("watch"), args, 0, 0); err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if s.behind() {
+		err = s.pull()
+		if err != nil {
+			return err
+		}
+	}
+	_, err = s.push("")
+	if errors.Is(err, errUnresolvedConflicts) {
+		log.Println(err)
+	} else if err != nil {
+		return err
+	}
+
+	stream, err := s.api.ChangeStream(context.Background(), &pb.ChangeStreamRequest{
+		ProjectId: s.client.ProjectConfig().GetProjectId(),
+	})
+	if err != nil {
+		return err
+	}
+	changes := make(chan *pb.ChangeStreamMessage)
+	streamErrs := make(chan error, 1)
+	go func() {
+		for {
+			in, err := stream.Recv()
+			if err == io.EOF {
+				log.Println("Stopped change stream")
+				return
+			}
+			if err != nil {
+				streamErrs <- fmt.Errorf("failed to receive a change stream message: %w", err)
+				return
+			}
+			changes <- in
+		}
+	}()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
+		if s.ignorer.Match(path, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		log.Println("Watching", path)
+		return watcher.Add(path)
+	}); err != nil {
+		return fmt.Errorf("could not walk directory tree to watch files: %w", err)
+	}
+
+	for {
+		select {
+		case <-changes:
+			log.Println("Got remote change")
+			err := s.pull()
+			if errors.Is(err, errJamdiffFound) {
+				log.Println(err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		case err := <-streamErrs:
+			return err
+		case event := <-watcher.Events:
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			path := event.Name
+			if filepath.Base(path) == jamignore.FileName {
+				s.ignorer.Reload()
+			}
+			if stat, err := os.Stat(path); err == nil && s.ignorer.Match(path, stat.IsDir()) {
+				continue
+			}
+
+			if stat, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+				log.Println(path + " deleted")
+				// The watch on a deleted path is removed automatically, so an
+				// error here only means that it is already gone.
+				_ = watcher.Remove(path)
+			} else if err != nil {
+				return err
+			} else if stat.IsDir() {
+				if err := filepath.WalkDir(path, func(path string, d fs.DirEntry, _ error) error {
+					if s.ignorer.Match(path, d.IsDir()) {
+						if d.IsDir() {
+							return fs.SkipDir
+						}
+						return nil
+					}
+					if d.IsDir() {
+						log.Println(path + " directory changed")
+					} else {
+						log.Println(path + " changed")
+					}
+
+					return watcher.Add(path)
+				}); err != nil {
+					return fmt.Errorf("could not walk directory tree to watch files: %w", err)
+				}
+			} else {
+				log.Println(path + " file changed")
+				err := watcher.Add(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err := s.push("")
+			if errors.Is(err, errUnresolvedConflicts) {
+				log.Println(err)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Println("error:", err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/jamignore"
+	"google.golang.org/protobuf/proto"
+)
+
+var errNotProject = errors.New("not a jamsync project (or any of the parent directories), run jam init or jam clone first")
+
+// alwaysIgnored are never synced regardless of the project's ignore patterns.
+var alwaysIgnored = []string{".jamsync"}
+
+// newIgnorer matches paths against the project's ignore patterns followed by any
+// .jamignore files in the current directory tree.
+func newIgnorer(config *pb.ProjectConfig) *jamignore.Matcher {
+	return jamignore.New(".", append(config.GetIgnorePatterns(), alwaysIgnored...))
+}
+
+// findJamsyncConfig looks for a .jamsync file in the current directory or any of its
+// parents and returns the config along with the directory it was found in.
+func findJamsyncConfig() (*pb.ProjectConfig, string, error) {
+	currentPath, err := os.Getwd()
+	if err != nil {
+		return nil, "", err
+	}
+	for {
+		filePath := filepath.Join(currentPath, ".jamsync")
+		configBytes, err := os.ReadFile(filePath)
+		if err == nil {
+			config := &pb.ProjectConfig{}
+			err = proto.Unmarshal(configBytes, config)
+			if err != nil {
+				return nil, "", err
+			}
+			return config, currentPath, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, "", err
+		}
+
+		parentPath := filepath.Dir(currentPath)
+		if parentPath == currentPath {
+			return nil, "", nil
+		}
+		currentPath = parentPath
+	}
+}
+
+// openProject changes to the root directory of the project containing the current
+// directory and returns its config.
+func openProject() (*pb.ProjectConfig, error) {
+	config, root, err := findJamsyncConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, errNotProject
+	}
+	return config, os.Chdir(root)
+}
+
+func writeJamsyncFile(config *pb.ProjectConfig) error {
+	f, err := os.Create(".jamsync")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	configBytes, err := proto.Marshal(config)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(configBytes)
+	return err
+}
+
+func directoryEmpty(path string) (bool, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	_, err = f.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+)
+
+// planDeletes returns the local paths that were deleted remotely since the change the
+// local directory was synced with, which baseFileMetadata lists. Paths that were
+// changed locally since then are returned as kept instead, so that local work is never
+// deleted.
+func planDeletes(baseFileMetadata *pb.FileMetadata, localFileMetadata *pb.FileMetadata, remoteToLocalDiff *pb.FileMetadataDiff) (deletes []string, kept []string) {
+	for path, diff := range remoteToLocalDiff.GetDiffs() {
+		if diff.GetType() != pb.FileMetadataDiff_Delete {
+			continue
+		}
+		baseFile, found := baseFileMetadata.GetFiles()[path]
+		if !found {
+			// Created locally and not pushed yet.
+			continue
+		}
+		localFile, found := localFileMetadata.GetFiles()[path]
+		if !found {
+			continue
+		}
+		if localFile.GetDir() != baseFile.GetDir() || localFile.GetHash() != baseFile.GetHash() || localFile.GetSymlinkTarget() != baseFile.GetSymlinkTarget() {
+			kept = append(kept, path)
+			continue
+		}
+		deletes = append(deletes, path)
+	}
+	sort.Strings(deletes)
+	sort.Strings(kept)
+	return deletes, kept
+}
+
+// applyDeletes removes the files in deletes and then the directories in it that are
+// empty, deepest first. Directories still holding other files are left in place.
+func applyDeletes(deletes []string) error {
+	dirs := make([]string, 0)
+	for _, path := range deletes {
+		info, err := os.Lstat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			dirs = append(dirs, path)
+			continue
+		}
+		log.Println("Deleting", path)
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		return strings.Count(dirs[i], "/") > strings.Count(dirs[j], "/")
+	})
+	for _, dir := range dirs {
+		err := os.Remove(dir)
+		if errors.Is(err, syscall.ENOTEMPTY) || errors.Is(err, syscall.EEXIST) {
+			log.Println("Keeping", dir, "since it still has files in it")
+			continue
+		}
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		log.Println("Deleted", dir)
+	}
+	return nil
+}
+
+func logKept(kept []string) {
+	for _, path := range kept {
+		log.Println("Not deleting", path, "since it was changed locally, delete it by hand if it is no longer needed")
+	}
+}
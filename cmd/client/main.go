@@ -1,558 +1,186 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
-	"io/fs"
 	"log"
 	"os"
-	"path"
-	"path/filepath"
-	"strings"
 
-	"github.com/cespare/xxhash"
-	"github.com/fsnotify/fsnotify"
 	"github.com/zdgeier/jamsync/gen/pb"
-	jam "github.com/zdgeier/jamsync/internal/server/client"
 	"github.com/zdgeier/jamsync/internal/server/clientauth"
 	"github.com/zdgeier/jamsync/internal/server/server"
 	"golang.org/x/oauth2"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func main() {
-	accessToken, err := clientauth.InitConfig()
-	if err != nil {
-		log.Panic(err)
-	}
-	apiClient, closer, err := server.Connect(&oauth2.Token{
-		AccessToken: accessToken,
-	})
-	if err != nil {
-		log.Panic(err)
-	}
-	defer closer()
-
-	_, err = apiClient.Ping(context.Background(), &pb.PingRequest{})
-	if err != nil {
-		accessToken, err := clientauth.ReauthConfig()
-		if err != nil {
-			log.Panic(err)
-		}
-		apiClient, closer, err = server.Connect(&oauth2.Token{
-			AccessToken: accessToken,
-		})
-		if err != nil {
-			log.Panic(err)
-		}
-		defer closer()
-	}
-
-	currentPath, err := os.Getwd()
-	if err != nil {
-		log.Panic(err)
-	}
-
-	empty, err := currentDirectoryEmpty()
-	if err != nil {
-		log.Panic(err)
-	}
-
-	var client *jam.Client
-	if empty {
-		log.Println("This directory is empty.")
-		log.Print("Name of project to download: ")
-		var projectName string
-		fmt.Scan(&projectName)
-
-		resp, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
-			ProjectName: projectName,
-		})
-		if err != nil {
-			log.Panic(err)
-		}
-
-		client = jam.NewClient(apiClient, resp.ProjectId, resp.CurrentChange)
-
-		diffRemoteToLocalResp, err := client.DiffRemoteToLocal(context.Background(), &pb.FileMetadata{})
-		if err != nil {
-			log.Panic(err)
-		}
-
-		err = applyFileListDiff(diffRemoteToLocalResp, client)
-		if err != nil {
-			log.Panic(err)
-		}
-
-		log.Println("Done downloading.")
-		err = writeJamsyncFile(client.ProjectConfig())
-		if err != nil {
-			log.Panic(err)
-		}
-	} else if config := findJamsyncConfig(); config != nil {
-		client = jam.NewClient(apiClient, config.ProjectId, config.CurrentChange)
-	} else {
-		log.Println("This directory has some existing contents.")
-		log.Println("Name of new project to create for current directory: ")
-		var projectName string
-		fmt.Scan(&projectName)
-
-		resp, err := apiClient.AddProject(context.Background(), &pb.AddProjectRequest{
-			ProjectName: projectName,
-		})
-		if err != nil {
-			log.Panic(err)
-		}
-		log.Println("Initializing a project at " + currentPath)
-
-		client = jam.NewClient(apiClient, resp.ProjectId, 0)
-		err = uploadNewProject(client)
-		if err != nil {
-			log.Panic(err)
-		}
-	}
-
-	// Get what has changed locally since last push
-	fileMetadata := readLocalFileList()
-	localToRemoteDiff, err := client.DiffLocalToRemote(context.Background(), fileMetadata)
-	if err != nil {
-		log.Panic(err)
-	}
-
-	remoteConfig, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
-		ProjectId: client.ProjectConfig().GetProjectId(),
-	})
-	if err != nil {
-		log.Panic(err)
-	}
-
-	if client.ProjectConfig().CurrentChange == remoteConfig.CurrentChange {
-		if diffHasChanges(localToRemoteDiff) {
-			err = pushFileListDiff(fileMetadata, localToRemoteDiff, client)
-			if err != nil {
-				log.Panic(err)
-			}
-			writeJamsyncFile(client.ProjectConfig())
-		}
-
-		stream, err := apiClient.ChangeStream(context.Background(), &pb.ChangeStreamRequest{
-			ProjectId: client.ProjectConfig().GetProjectId(),
-		})
-		if err != nil {
-			log.Panic(err)
-		}
-		changes := make(chan *pb.ChangeStreamMessage)
-		go func() {
-			for {
-				in, err := stream.Recv()
-				if err == io.EOF {
-					log.Println("Stopped change stream")
-					return
-				}
-				if err != nil {
-					log.Fatalf("Failed to receive a change stream message: %v", err)
-				}
-				changes <- in
-			}
-		}()
-
-		watcher, _ := fsnotify.NewWatcher()
-		defer watcher.Close()
-
-		if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
-			if shouldExclude(path) {
-				return nil
-			}
-			log.Println("Watching", path)
-			return watcher.Add(path)
-		}); err != nil {
-			log.Panic("Could not walk directory tree to watch files", err)
-		}
-
-		for {
-			select {
-			case <-changes:
-				log.Println("Got remote change")
-				fileMetadata := readLocalFileList()
-				localToRemoteDiff, err := client.DiffLocalToRemote(context.Background(), fileMetadata)
-				if err != nil {
-					log.Panic(err)
-				}
-
-				remoteConfig, err := apiClient.GetProjectConfig(context.Background(), &pb.GetProjectConfigRequest{
-					ProjectId: client.ProjectConfig().GetProjectId(),
-				})
-				if err != nil {
-					log.Panic(err)
-				}
-				client = jam.NewClient(apiClient, remoteConfig.ProjectId, remoteConfig.CurrentChange)
-				remoteToLocalDiff, err := client.DiffRemoteToLocal(context.Background(), fileMetadata)
-				if err != nil {
-					log.Panic(err)
-				}
-
-				pull(client, localToRemoteDiff, remoteToLocalDiff)
-			case event := <-watcher.Events:
-				if event.Op == fsnotify.Chmod {
-					continue
-				}
-
-				path := event.Name
-
-				if stat, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
-					log.Println(path + " deleted")
-					err := watcher.Remove(path)
-					if err != nil {
-						log.Fatal(err)
-					}
-				} else if stat.IsDir() {
-					if err := filepath.WalkDir(path, func(path string, d fs.DirEntry, _ error) error {
-						if d.IsDir() {
-							log.Println(path + " directory changed")
-						} else {
-							log.Println(path + " changed")
-						}
-
-						return watcher.Add(path)
-					}); err != nil {
-						log.Panic("Could not walk directory tree to watch files")
-					}
-				} else {
-					log.Println(path + " file changed")
-					err := watcher.Add(path)
-					if err != nil {
-						log.Fatal(err)
-					}
-				}
-				fileMetadata := readLocalFileList()
-				localToRemoteDiff, err := client.DiffLocalToRemote(context.Background(), fileMetadata)
-				if err != nil {
-					log.Panic(err)
-				}
-				if diffHasChanges(localToRemoteDiff) {
-					err = pushFileListDiff(fileMetadata, localToRemoteDiff, client)
-					if err != nil {
-						log.Panic(err)
-					}
-					writeJamsyncFile(client.ProjectConfig())
-				}
-			case err, ok := <-watcher.Errors:
-				if !ok {
-					return
-				}
-				log.Println("error:", err)
-			}
-		}
-	} else {
-		client = jam.NewClient(apiClient, remoteConfig.ProjectId, remoteConfig.CurrentChange)
-		remoteToLocalDiff, err := client.DiffRemoteToLocal(context.Background(), fileMetadata)
-		if err != nil {
-			log.Panic(err)
-		}
+// Exit codes returned by jam.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
 
-		pull(client, localToRemoteDiff, remoteToLocalDiff)
-	}
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(args []string) error
 }
 
-func diffHasChanges(diff *pb.FileMetadataDiff) bool {
-	for _, diff := range diff.GetDiffs() {
-		if diff.Type != pb.FileMetadataDiff_NoOp {
-			return true
-		}
+var commands []command
+
+func init() {
+	commands = []command{
+		{"init", "[-name <project>]", "Create a project from the current directory and upload it", runInit},
+		{"clone", "[-branch <branch>] <project> [directory]", "Download a project into a new directory", runClone},
+		{"push", "[-m <message>]", "Upload local changes as a new change", runPush},
+		{"pull", "[-dry-run]", "Download remote changes into the local directory", runPull},
+		{"status", "", "Show how the local directory differs from the project", runStatus},
+		{"watch", "", "Sync, then keep syncing local and remote changes as they happen", runWatch},
+		{"log", "[-n <count>]", "List committed changes, newest first", runLog},
+		{"diff", "<path> [-from <change>] [-to <change>]", "Show how a file changed between two changes", runDiff},
+		{"restore", "<path> -change <change> [-m <message>]", "Restore a file or directory to how it was at a change", runRestore},
+		{"revert", "[-m <message>] <change>", "Restore the whole project to how it was at a change", runRevert},
+		{"branch", "[-from <branch>] [name]", "List branches, or create one and switch to it", runBranch},
+		{"switch", "<branch>", "Move the local directory to another branch", runSwitch},
+		{"merge", "[-m <message>] <branch>", "Commit the changes of a branch to the branch it was forked from", runMerge},
+		{"tag", "[-m <message>] [-change <change>] [-delete] [name]", "List tags, or name a change so it can be checked out again", runTag},
+		{"checkout", "<tag|change>", "Move the local directory to a tag or change", runCheckout},
+		{"projects", "[-archived]", "List your projects", runProjects},
+		{"rename", "<project> <name>", "Rename a project", runRename},
+		{"archive", "[-undo] <project>", "Make a project read-only and hide it from the project list", runArchive},
+		{"delete-project", "-yes <project>", "Delete a project and all of its changes for good", runDeleteProject},
 	}
-	return false
 }
 
-func pull(client *jam.Client, localToRemoteDiff *pb.FileMetadataDiff, remoteToLocalDiff *pb.FileMetadataDiff) {
-	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
-		if !d.IsDir() {
-			if strings.HasSuffix(path, ".jamdiff") {
-				return fmt.Errorf(".jamdiff file found at %s", path)
-			}
-		}
-		return nil
-	}); err != nil {
-		log.Println(err)
-		return
-	}
-
-	dirty := false
-	for path, remoteDiff := range remoteToLocalDiff.GetDiffs() {
-		if remoteDiff.GetType() != pb.FileMetadataDiff_NoOp {
-			// Local has changed
-			if localDiff, found := localToRemoteDiff.GetDiffs()[path]; found && localDiff.GetType() != pb.FileMetadataDiff_NoOp {
-				if localDiff.GetFile().Hash == remoteDiff.GetFile().Hash {
-					newModTime := remoteDiff.File.GetModTime().AsTime()
-					err := os.Chtimes(path, newModTime, newModTime)
-					if err != nil {
-						log.Panic(err)
-					}
-					continue
-				}
-				file, err := os.OpenFile(path+".jamdiff", os.O_RDWR|os.O_CREATE, 0755)
-				if err != nil {
-					log.Panic(err)
-				}
-
-				reader, err := os.Open(path)
-				if err != nil {
-					log.Panic(err)
-				}
+// usageError is returned by commands when they are invoked incorrectly.
+type usageError struct {
+	msg string
+}
 
-				err = client.DownloadFile(context.Background(), path, reader, file)
-				if err != nil {
-					log.Panic(err)
-				}
-				newModTime := remoteDiff.File.GetModTime().AsTime()
-				err = os.Chtimes(path, newModTime, newModTime)
-				if err != nil {
-					log.Panic(err)
-				}
-				dirty = true
-			}
-		}
-	}
+func (e usageError) Error() string {
+	return e.msg
+}
 
-	if dirty {
-		writeJamsyncFile(client.ProjectConfig())
-		log.Println("merge .jamdiff files to continue")
-		return
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		os.Exit(exitUsage)
 	}
 
-	err := applyFileListDiff(remoteToLocalDiff, client)
-	if err != nil {
-		log.Panic(err)
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		printUsage()
+		os.Exit(exitOK)
 	}
 
-	log.Println("Done downloading.")
-}
-
-func findJamsyncConfig() *pb.ProjectConfig {
-	currentPath, err := os.Getwd()
-	if err != nil {
-		panic(err)
-	}
-	for {
-		filePath := fmt.Sprintf("%v/%v", currentPath, ".jamsync")
-		_, err := os.Stat(filePath)
-		if err != nil && !errors.Is(err, os.ErrNotExist) {
-			panic(err)
-		} else if err == nil {
-			if configBytes, err := os.ReadFile(filePath); err == nil {
-				config := &pb.ProjectConfig{}
-				err = proto.Unmarshal(configBytes, config)
-				if err != nil {
-					panic(err)
-				}
-				return config
-			}
-		} else if currentPath == "/" {
-			break
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(os.Args[2:])
+		var usageErr usageError
+		switch {
+		case err == nil:
+			os.Exit(exitOK)
+		case errors.Is(err, flag.ErrHelp):
+			os.Exit(exitOK)
+		case errors.As(err, &usageErr):
+			fmt.Fprintf(os.Stderr, "jam %s: %s\nusage: jam %s %s\n", cmd.name, usageErr.msg, cmd.name, cmd.args)
+			os.Exit(exitUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "jam %s: %s\n", cmd.name, err)
+			os.Exit(exitError)
 		}
-		currentPath = path.Dir(currentPath)
 	}
-	return nil
-}
 
-func writeJamsyncFile(config *pb.ProjectConfig) error {
-	f, err := os.Create(".jamsync")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	fmt.Fprintf(os.Stderr, "jam: unknown command %q\n", name)
+	printUsage()
+	os.Exit(exitUsage)
+}
 
-	configBytes, err := proto.Marshal(config)
-	if err != nil {
-		return err
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "usage: jam <command> [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", cmd.name, cmd.summary)
 	}
-	_, err = f.Write(configBytes)
-	return err
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Set JAM_TOKEN to an access token to run without an interactive login.")
 }
 
-func uploadNewProject(client *jam.Client) error {
-	fileMetadata := readLocalFileList()
-	fileMetadataDiff, err := client.DiffLocalToRemote(context.Background(), fileMetadata)
-	if err != nil {
-		return err
-	}
-	err = pushFileListDiff(fileMetadata, fileMetadataDiff, client)
-	if err != nil {
-		return err
-	}
-	return writeJamsyncFile(client.ProjectConfig())
+// newFlagSet returns a flag set for a command that reports errors instead of exiting.
+// It prints nothing itself, since main reports the errors along with the usage.
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("jam "+name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
 }
 
-func readLocalFileList() *pb.FileMetadata {
-	files := map[string]*pb.File{}
-	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
-		if shouldExclude(path) {
-			return nil
+// parseFlags parses args, which may mix flags and positional arguments, and returns
+// the positional arguments after checking that there are between min and max of them.
+func parseFlags(flags *flag.FlagSet, args []string, min int, max int) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		err := flags.Parse(args)
+		if errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "Usage of %s:\n", flags.Name())
+			flags.SetOutput(os.Stderr)
+			flags.PrintDefaults()
+			return nil, err
 		}
-		info, err := d.Info()
 		if err != nil {
-			return err
+			return nil, usageError{err.Error()}
 		}
-
-		if d.IsDir() {
-			files[path] = &pb.File{
-				ModTime: timestamppb.New(info.ModTime()),
-				Dir:     true,
-			}
-		} else {
-			data, err := os.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			h := xxhash.New()
-			h.Write(data)
-
-			files[path] = &pb.File{
-				ModTime: timestamppb.New(info.ModTime()),
-				Dir:     false,
-				Hash:    h.Sum64(),
-			}
+		if flags.NArg() == 0 {
+			break
 		}
-		return nil
-	}); err != nil {
-		log.Println("WARN: could not walk directory tree", err)
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
 	}
-
-	return &pb.FileMetadata{
-		Files: files,
+	if len(positional) < min || len(positional) > max {
+		return nil, usageError{"wrong number of arguments"}
 	}
+	return positional, nil
 }
 
-func pushFileListDiff(fileMetadata *pb.FileMetadata, fileMetadataDiff *pb.FileMetadataDiff, client *jam.Client) error {
-	ctx := context.Background()
-
-	err := client.CreateChange()
-	if err != nil {
-		return err
-	}
-
-	for path, diff := range fileMetadataDiff.GetDiffs() {
-		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetType() != pb.FileMetadataDiff_Delete && !diff.GetFile().GetDir() {
-			file, err := os.OpenFile(path, os.O_RDONLY, 0755)
-			if err != nil {
-				return err
-			}
-			log.Println("Uploading", path)
-			err = client.UploadFile(ctx, path, file)
-			if err != nil {
-				return err
-			}
-			file.Close()
+// connect returns an authenticated API client. When JAM_TOKEN is set it is used as
+// the access token and the interactive login flow is never started.
+func connect() (pb.JamsyncAPIClient, func(), error) {
+	accessToken := os.Getenv("JAM_TOKEN")
+	interactive := accessToken == ""
+	if interactive {
+		var err error
+		accessToken, err = clientauth.InitConfig()
+		if err != nil {
+			return nil, nil, err
 		}
 	}
-	log.Println("Uploading file list...")
 
-	metadataBytes, err := proto.Marshal(fileMetadata)
-	if err != nil {
-		return err
-	}
-	err = client.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(metadataBytes))
+	apiClient, closer, err := server.Connect(&oauth2.Token{
+		AccessToken: accessToken,
+	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	err = client.CommitChange()
+	_, err = apiClient.Ping(context.Background(), &pb.PingRequest{})
 	if err != nil {
-		return err
-	}
-	log.Println("Committed changes.")
-
-	return nil
-}
-
-func applyFileListDiff(fileMetadataDiff *pb.FileMetadataDiff, client *jam.Client) error {
-	ctx := context.Background()
-	for path, diff := range fileMetadataDiff.GetDiffs() {
-		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetFile().GetDir() {
-			log.Println("Creating ", diff.GetFile().GetDir())
-			err := os.MkdirAll(path, os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	paths := make(chan string, len(fileMetadataDiff.GetDiffs()))
-	results := make(chan error, len(fileMetadataDiff.GetDiffs()))
-
-	worker := func(id int, paths <-chan string, results chan<- error) {
-		for path := range paths {
-			file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0755)
-			if err != nil {
-				results <- err
-				return
-			}
-
-			fileContents, err := os.ReadFile(path)
-			if err != nil {
-				results <- err
-				return
-			}
-
-			log.Println("Downloading ", path)
-			err = client.DownloadFile(ctx, path, bytes.NewReader(fileContents), file)
-			if err != nil {
-				results <- err
-				return
-			}
-
-			newModTime := fileMetadataDiff.GetDiffs()[path].File.GetModTime().AsTime()
-			err = os.Chtimes(path, newModTime, newModTime)
-			if err != nil {
-				results <- err
-			}
-
-			results <- file.Close()
-		}
-	}
-
-	for w := 1; w <= 10; w++ {
-		go worker(w, paths, results)
-	}
-	for path, diff := range fileMetadataDiff.GetDiffs() {
-		if diff.GetType() != pb.FileMetadataDiff_NoOp && !diff.GetFile().GetDir() {
-			paths <- path
+		closer()
+		if !interactive {
+			return nil, nil, err
 		}
-	}
-	close(paths)
-	done := 0
-	batchesDone := 0
-	for _, diff := range fileMetadataDiff.GetDiffs() {
-		if diff.GetType() != pb.FileMetadataDiff_NoOp && !diff.GetFile().GetDir() {
-			<-results
-			done += 1
-			if done > 1000 {
-				log.Println("Done: ", batchesDone*1000)
-				batchesDone += 1
-				done = 0
-			}
+		log.Println("Could not reach jamsync with the saved login, logging in again")
+		accessToken, err := clientauth.ReauthConfig()
+		if err != nil {
+			return nil, nil, err
 		}
+		return server.Connect(&oauth2.Token{
+			AccessToken: accessToken,
+		})
 	}
-	return nil
-}
-
-func currentDirectoryEmpty() (bool, error) {
-	f, err := os.Open(".")
-	if err != nil {
-		return false, err
-	}
-	defer f.Close()
-
-	_, err = f.Readdirnames(1)
-	if err == io.EOF {
-		return true, nil
-	}
-	return false, err
-}
-
-func shouldExclude(path string) bool {
-	return strings.HasPrefix(path, ".next") || strings.HasPrefix(path, "node_modules") || strings.HasSuffix(path, ".jamsync") || strings.HasPrefix(path, ".git") || strings.HasPrefix(path, "jb")
+	return apiClient, closer, nil
 }
@@ -0,0 +1,34 @@
+package main
+
+import "io/fs"
+
+// posixMode returns the POSIX permission bits of mode, which differ from FileMode for
+// the setuid, setgid and sticky bits.
+func posixMode(mode fs.FileMode) uint32 {
+	posix := uint32(mode.Perm())
+	if mode&fs.ModeSetuid != 0 {
+		posix |= 0o4000
+	}
+	if mode&fs.ModeSetgid != 0 {
+		posix |= 0o2000
+	}
+	if mode&fs.ModeSticky != 0 {
+		posix |= 0o1000
+	}
+	return posix
+}
+
+// fileMode is the inverse of posixMode.
+func fileMode(posix uint32) fs.FileMode {
+	mode := fs.FileMode(posix & 0o777)
+	if posix&0o4000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if posix&0o2000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if posix&0o1000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+)
+
+// fileChanges are the paths created, updated, moved and deleted on one side since the
+// change the local directory was last synced with. Moves are described as
+// "from -> to" and their source is not listed as deleted.
+type fileChanges struct {
+	created []string
+	updated []string
+	moved   []string
+	deleted []string
+}
+
+func newFileChanges(diff *pb.FileMetadataDiff) fileChanges {
+	var changes fileChanges
+	movedFrom := make(map[string]bool)
+	for path, fileDiff := range diff.GetDiffs() {
+		switch fileDiff.GetType() {
+		case pb.FileMetadataDiff_Create:
+			changes.created = append(changes.created, path)
+		case pb.FileMetadataDiff_Update:
+			changes.updated = append(changes.updated, path)
+		case pb.FileMetadataDiff_Move:
+			changes.moved = append(changes.moved, fileDiff.GetFromPath()+" -> "+path)
+			movedFrom[fileDiff.GetFromPath()] = true
+		}
+	}
+	for path, fileDiff := range diff.GetDiffs() {
+		if fileDiff.GetType() == pb.FileMetadataDiff_Delete && !movedFrom[path] {
+			changes.deleted = append(changes.deleted, path)
+		}
+	}
+	sort.Strings(changes.created)
+	sort.Strings(changes.updated)
+	sort.Strings(changes.moved)
+	sort.Strings(changes.deleted)
+	return changes
+}
+
+func (c fileChanges) empty() bool {
+	return len(c.created) == 0 && len(c.updated) == 0 && len(c.moved) == 0 && len(c.deleted) == 0
+}
+
+func (c fileChanges) print() {
+	for _, path := range c.created {
+		fmt.Println("  created:", path)
+	}
+	for _, path := range c.updated {
+		fmt.Println("  updated:", path)
+	}
+	for _, move := range c.moved {
+		fmt.Println("  moved:  ", move)
+	}
+	for _, path := range c.deleted {
+		fmt.Println("  deleted:", path)
+	}
+}
+
+// projectStatus describes how the local directory and the project have diverged
+// without changing either of them.
+type projectStatus struct {
+	local         fileChanges
+	remote        fileChanges
+	conflicts     []string
+	changesBehind int
+}
+
+func (s *session) status() (*projectStatus, error) {
+	ctx := context.Background()
+	localToRemoteDiff, err := s.client.DiffLocalToRemote(ctx, readLocalFileList(s.ignorer))
+	if err != nil {
+		return nil, err
+	}
+
+	st := &projectStatus{
+		local: newFileChanges(localToRemoteDiff),
+	}
+	if !s.behind() {
+		return st, nil
+	}
+
+	// Remote changes are found by comparing the file list at the local change with
+	// the one at the latest remote change, so that local edits don't show up twice.
+	baseFileMetadata, err := s.client.FileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remoteClient := s.newClient(s.remote.GetCurrentChange())
+	baseToRemoteDiff, err := remoteClient.DiffRemoteToLocal(ctx, baseFileMetadata)
+	if err != nil {
+		return nil, err
+	}
+	st.remote = newFileChanges(baseToRemoteDiff)
+
+	for path, localDiff := range localToRemoteDiff.GetDiffs() {
+		remoteDiff, found := baseToRemoteDiff.GetDiffs()[path]
+		if found && conflicting(localDiff, remoteDiff) {
+			st.conflicts = append(st.conflicts, path)
+		}
+	}
+	sort.Strings(st.conflicts)
+
+	resp, err := s.api.ListCommittedChanges(ctx, &pb.ListCommittedChangesRequest{
+		ProjectId: s.remote.GetProjectId(),
+		Branch:    s.remote.GetBranch(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, changeId := range resp.GetChangeIds() {
+		if changeId > s.client.ProjectConfig().GetCurrentChange() && changeId <= s.remote.GetCurrentChange() {
+			st.changesBehind++
+		}
+	}
+	return st, nil
+}
+
+// conflicting reports whether a path was changed differently on both sides.
+func conflicting(localDiff *pb.FileMetadataDiff_FileDiff, remoteDiff *pb.FileMetadataDiff_FileDiff) bool {
+	if localDiff.GetType() == pb.FileMetadataDiff_NoOp || remoteDiff.GetType() == pb.FileMetadataDiff_NoOp {
+		return false
+	}
+	localDeleted := localDiff.GetType() == pb.FileMetadataDiff_Delete
+	remoteDeleted := remoteDiff.GetType() == pb.FileMetadataDiff_Delete
+	if localDeleted || remoteDeleted {
+		return localDeleted != remoteDeleted
+	}
+	return localDiff.GetFile().GetDir() != remoteDiff.GetFile().GetDir() ||
+		localDiff.GetFile().GetHash() != remoteDiff.GetFile().GetHash()
+}
+
+func (st *projectStatus) print(s *session) {
+	fmt.Printf("Project %d on branch %s at change %d\n", s.remote.GetProjectId(), s.remote.GetBranch(), s.client.ProjectConfig().GetCurrentChange())
+	if s.behind() {
+		fmt.Printf("%d change(s) behind remote change %d, run jam pull to update\n", st.changesBehind, s.remote.GetCurrentChange())
+	} else {
+		fmt.Println("Up to date with remote")
+	}
+
+	fmt.Println()
+	if st.local.empty() {
+		fmt.Println("No local changes")
+	} else {
+		fmt.Println("Local changes:")
+		st.local.print()
+	}
+
+	if !st.remote.empty() {
+		fmt.Println()
+		fmt.Println("Remote changes:")
+		st.remote.print()
+	}
+
+	if len(st.conflicts) > 0 {
+		fmt.Println()
+		fmt.Println("Changed both locally and remotely:")
+		for _, path := range st.conflicts {
+			fmt.Println("  conflict:", path)
+		}
+	}
+}
@@ -0,0 +1,462 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/diff"
+	"github.com/zdgeier/jamsync/internal/jamignore"
+	jam "github.com/zdgeier/jamsync/internal/server/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func diffHasChanges(diff *pb.FileMetadataDiff) bool {
+	for _, diff := range diff.GetDiffs() {
+		if diff.Type != pb.FileMetadataDiff_NoOp {
+			return true
+		}
+	}
+	return false
+}
+
+var errUnresolvedConflicts = errors.New("unresolved conflict markers")
+
+// conflictedFiles returns the files that a push of localToRemoteDiff would upload while
+// they still have conflict markers left by a merge, in sorted order.
+func conflictedFiles(localToRemoteDiff *pb.FileMetadataDiff) ([]string, error) {
+	conflicted := make([]string, 0)
+	for path, fileDiff := range localToRemoteDiff.GetDiffs() {
+		if fileDiff.GetType() == pb.FileMetadataDiff_NoOp || fileDiff.GetType() == pb.FileMetadataDiff_Delete ||
+			fileDiff.GetFile().GetDir() || fileDiff.GetFile().GetSymlinkTarget() != "" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if diff.HasConflicts(data) {
+			conflicted = append(conflicted, path)
+		}
+	}
+	sort.Strings(conflicted)
+	return conflicted, nil
+}
+
+// pull applies remote changes to the local directory. Files that were changed both
+// locally and remotely are merged against their version at baseClient's change, and
+// deletes are removed once everything else has been downloaded. Binary files that
+// can't be merged get a .jamdiff with their remote version, and pull fails with
+// errJamdiffFound after applying every other remote change.
+func pull(baseClient *jam.Client, client *jam.Client, localToRemoteDiff *pb.FileMetadataDiff, remoteToLocalDiff *pb.FileMetadataDiff, deletes []string) error {
+	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
+		if !d.IsDir() {
+			if strings.HasSuffix(path, ".jamdiff") {
+				return fmt.Errorf("%w at %s", errJamdiffFound, path)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	merged := make(map[string]bool)
+	jamdiffs := make(map[string]bool)
+	for path, remoteDiff := range remoteToLocalDiff.GetDiffs() {
+		if remoteDiff.GetType() != pb.FileMetadataDiff_NoOp {
+			// Local has changed
+			if localDiff, found := localToRemoteDiff.GetDiffs()[path]; found && localDiff.GetType() != pb.FileMetadataDiff_NoOp {
+				if localDiff.GetFile().Hash == remoteDiff.GetFile().Hash {
+					if remoteDiff.GetFile().GetSymlinkTarget() != "" {
+						continue
+					}
+					newModTime := remoteDiff.File.GetModTime().AsTime()
+					err := os.Chtimes(path, newModTime, newModTime)
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				if localDiff.GetFile().GetDir() || remoteDiff.GetFile().GetDir() ||
+					localDiff.GetFile().GetSymlinkTarget() != "" || remoteDiff.GetFile().GetSymlinkTarget() != "" {
+					continue
+				}
+
+				conflicts, err := mergeFile(baseClient, client, path)
+				if errors.Is(err, errBinaryMerge) {
+					// Binary files cannot be merged by line so leave a copy of the
+					// remote file next to the local one to be resolved by hand.
+					err = writeJamdiff(client, path)
+					if err != nil {
+						return err
+					}
+					newModTime := remoteDiff.File.GetModTime().AsTime()
+					err = os.Chtimes(path, newModTime, newModTime)
+					if err != nil {
+						return err
+					}
+					jamdiffs[path] = true
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				if conflicts > 0 {
+					log.Printf("%d conflict(s) merging %s, resolve the conflict markers\n", conflicts, path)
+				} else {
+					log.Println("Merged", path)
+				}
+				merged[path] = true
+			}
+		}
+	}
+
+	// Merged files now hold both sets of changes and will be pushed as local
+	// changes, so they must not be overwritten by the remote version. Neither must
+	// files whose remote version was left in a .jamdiff to be merged by hand.
+	diffs := make(map[string]*pb.FileMetadataDiff_FileDiff, len(remoteToLocalDiff.GetDiffs()))
+	for path, diff := range remoteToLocalDiff.GetDiffs() {
+		if !merged[path] && !jamdiffs[path] {
+			diffs[path] = diff
+		}
+	}
+	err := applyFileListDiff(&pb.FileMetadataDiff{Diffs: diffs}, client)
+	if err != nil {
+		return err
+	}
+	// Moved files are downloaded using their old path, so it is only deleted now.
+	err = applyDeletes(deletes)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Done downloading.")
+	err = writeJamsyncFile(client.ProjectConfig())
+	if err != nil {
+		return err
+	}
+	if len(jamdiffs) > 0 {
+		return fmt.Errorf("%w, merge them to continue", errJamdiffFound)
+	}
+	return nil
+}
+
+var errJamdiffFound = errors.New(".jamdiff file found")
+
+var errBinaryMerge = errors.New("cannot merge binary file")
+
+// mergeFile three-way merges the local version of path with the version at client's
+// change, using the version at baseClient's change as the common ancestor. The merged
+// result, including any conflict markers, replaces the local file. A file with conflict
+// markers left in it is not pushed, see conflictedFiles.
+func mergeFile(baseClient *jam.Client, client *jam.Client, path string) (int, error) {
+	ctx := context.Background()
+	local, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	base := new(bytes.Buffer)
+	err = baseClient.DownloadFile(ctx, path, bytes.NewReader(local), base)
+	if err != nil {
+		return 0, err
+	}
+	remote := new(bytes.Buffer)
+	err = client.DownloadFile(ctx, path, bytes.NewReader(local), remote)
+	if err != nil {
+		return 0, err
+	}
+
+	if diff.IsBinary(base.Bytes()) || diff.IsBinary(local) || diff.IsBinary(remote.Bytes()) {
+		return 0, errBinaryMerge
+	}
+
+	merged, conflicts := diff.Merge3(base.Bytes(), local, remote.Bytes())
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return conflicts, os.WriteFile(path, merged, info.Mode().Perm())
+}
+
+func writeJamdiff(client *jam.Client, path string) error {
+	file, err := os.OpenFile(path+".jamdiff", os.O_RDWR|os.O_CREATE, 0755)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return client.DownloadFile(context.Background(), path, reader, file)
+}
+
+func uploadNewProject(client *jam.Client, ignorer *jamignore.Matcher) error {
+	fileMetadata := readLocalFileList(ignorer)
+	fileMetadataDiff, err := client.DiffLocalToRemote(context.Background(), fileMetadata)
+	if err != nil {
+		return err
+	}
+	err = pushFileListDiff(fileMetadata, fileMetadataDiff, client, "Initial upload")
+	if err != nil {
+		return err
+	}
+	return writeJamsyncFile(client.ProjectConfig())
+}
+
+func readLocalFileList(ignorer *jamignore.Matcher) *pb.FileMetadata {
+	files := map[string]*pb.File{}
+	if err := filepath.WalkDir(".", func(path string, d fs.DirEntry, _ error) error {
+		if ignorer.Match(path, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			files[path] = &pb.File{
+				Hash:          xxhash.Sum64String(target),
+				SymlinkTarget: target,
+			}
+		} else if d.IsDir() {
+			files[path] = &pb.File{
+				ModTime: timestamppb.New(info.ModTime()),
+				Dir:     true,
+				Mode:    posixMode(info.Mode()),
+			}
+		} else {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			h := xxhash.New()
+			h.Write(data)
+
+			files[path] = &pb.File{
+				ModTime: timestamppb.New(info.ModTime()),
+				Dir:     false,
+				Hash:    h.Sum64(),
+				Mode:    posixMode(info.Mode()),
+			}
+		}
+		return nil
+	}); err != nil {
+		log.Println("WARN: could not walk directory tree", err)
+	}
+
+	return &pb.FileMetadata{
+		Files: files,
+	}
+}
+
+func pushFileListDiff(fileMetadata *pb.FileMetadata, fileMetadataDiff *pb.FileMetadataDiff, client *jam.Client, message string) error {
+	err := client.CreateChange(message)
+	if err != nil {
+		return err
+	}
+
+	err = uploadFileListDiff(fileMetadata, fileMetadataDiff, client)
+	if err == nil {
+		err = client.CommitChange()
+	}
+	if err != nil {
+		// Don't leave a half uploaded change open on the server.
+		if abortErr := client.AbortChange(); abortErr != nil {
+			log.Println("WARN: could not abort change:", abortErr)
+		}
+		return err
+	}
+	log.Println("Committed changes.")
+
+	return nil
+}
+
+func uploadFileListDiff(fileMetadata *pb.FileMetadata, fileMetadataDiff *pb.FileMetadataDiff, client *jam.Client) error {
+	ctx := context.Background()
+	for path, diff := range fileMetadataDiff.GetDiffs() {
+		if diff.GetType() == pb.FileMetadataDiff_Move {
+			log.Println("Moving", diff.GetFromPath(), "to", path)
+			err := client.CopyFile(ctx, diff.GetFromPath(), path, diff.GetFile().GetHash())
+			if err == nil {
+				continue
+			}
+			if code := status.Code(err); code != codes.FailedPrecondition && code != codes.NotFound {
+				return err
+			}
+			// The server no longer has the content being moved, so upload it instead.
+		}
+		// Symlinks have no content, their target is in the file list.
+		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetType() != pb.FileMetadataDiff_Delete && !diff.GetFile().GetDir() && diff.GetFile().GetSymlinkTarget() == "" {
+			file, err := os.OpenFile(path, os.O_RDONLY, 0755)
+			if err != nil {
+				return err
+			}
+			log.Println("Uploading", path)
+			err = client.UploadFile(ctx, path, file)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	log.Println("Uploading file list...")
+
+	metadataBytes, err := proto.Marshal(fileMetadata)
+	if err != nil {
+		return err
+	}
+	return client.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(metadataBytes))
+}
+
+func applyFileListDiff(fileMetadataDiff *pb.FileMetadataDiff, client *jam.Client) error {
+	ctx := context.Background()
+	for path, diff := range fileMetadataDiff.GetDiffs() {
+		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetFile().GetDir() {
+			log.Println("Creating ", path)
+			err := os.MkdirAll(path, os.ModePerm)
+			if err != nil {
+				return err
+			}
+			if diff.GetFile().GetMode() != 0 {
+				err = os.Chmod(path, fileMode(diff.GetFile().GetMode()))
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	paths := make(chan string, len(fileMetadataDiff.GetDiffs()))
+	results := make(chan error, len(fileMetadataDiff.GetDiffs()))
+
+	worker := func(id int, paths <-chan string, results chan<- error) {
+		for path := range paths {
+			diff := fileMetadataDiff.GetDiffs()[path]
+			basisPath := path
+			if diff.GetType() == pb.FileMetadataDiff_Move {
+				basisPath = diff.GetFromPath()
+			}
+			if diff.GetFile().GetSymlinkTarget() != "" {
+				results <- writeSymlink(path, diff.GetFile().GetSymlinkTarget())
+				continue
+			}
+			results <- downloadFile(ctx, client, path, basisPath, diff.GetFile())
+		}
+	}
+
+	for w := 1; w <= 10; w++ {
+		go worker(w, paths, results)
+	}
+	numPaths := 0
+	for path, diff := range fileMetadataDiff.GetDiffs() {
+		if diff.GetType() != pb.FileMetadataDiff_NoOp && diff.GetType() != pb.FileMetadataDiff_Delete && !diff.GetFile().GetDir() {
+			paths <- path
+			numPaths += 1
+		}
+	}
+	close(paths)
+	var firstErr error
+	for i := 1; i <= numPaths; i++ {
+		if err := <-results; err != nil && firstErr == nil {
+			firstErr = err
+		}
+		if i%1000 == 0 {
+			log.Println("Done: ", i)
+		}
+	}
+	return firstErr
+}
+
+// downloadFile updates the local file at path to the version at client's change,
+// using the current contents of basisPath as the basis for the transfer. basisPath is
+// the file itself unless it was moved from elsewhere.
+func downloadFile(ctx context.Context, client *jam.Client, path string, basisPath string, file *pb.File) error {
+	var fileContents []byte
+	basisInfo, err := os.Lstat(basisPath)
+	if err == nil && basisInfo.Mode().IsRegular() {
+		fileContents, err = os.ReadFile(basisPath)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	log.Println("Downloading ", path)
+	result := new(bytes.Buffer)
+	err = client.DownloadFile(ctx, path, bytes.NewReader(fileContents), result)
+	if err != nil {
+		return err
+	}
+
+	// Files without a recorded mode were pushed by older clients.
+	mode := fs.FileMode(0755)
+	if file.GetMode() != 0 {
+		mode = fileMode(file.GetMode())
+	}
+	err = replaceFile(path, result.Bytes(), mode)
+	if err != nil {
+		return err
+	}
+
+	newModTime := file.GetModTime().AsTime()
+	return os.Chtimes(path, newModTime, newModTime)
+}
+
+// replaceFile writes data to a new file with exactly mode and moves it to path, so
+// that read-only files and symlinks at path are replaced rather than written through.
+func replaceFile(path string, data []byte, mode fs.FileMode) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.jamtmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(data)
+	if err == nil {
+		// Chmod is not affected by the umask, unlike the mode a file is created with.
+		err = tmpFile.Chmod(mode)
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// writeSymlink makes path a symlink to target, replacing whatever is there.
+func writeSymlink(path string, target string) error {
+	if current, err := os.Readlink(path); err == nil && current == target {
+		return nil
+	}
+	log.Println("Linking", path, "to", target)
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.Symlink(target, path)
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cespare/xxhash"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/diff"
+	"github.com/zdgeier/jamsync/internal/jamignore"
+	jam "github.com/zdgeier/jamsync/internal/server/client"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReadLocalFileListModesAndSymlinks(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	require.NoError(t, os.Mkdir("config", 0755))
+	require.NoError(t, os.WriteFile("config/app.conf", []byte("key=value"), 0644))
+	require.NoError(t, os.WriteFile("build.sh", []byte("#!/bin/sh"), 0644))
+	require.NoError(t, os.Chmod("build.sh", 0755|fs.ModeSetgid))
+	require.NoError(t, os.Symlink("config", "config-link"))
+
+	files := readLocalFileList(jamignore.New(dir, nil)).GetFiles()
+	require.Equal(t, uint32(0o2755), files["build.sh"].GetMode())
+	require.Equal(t, uint32(0o644), files["config/app.conf"].GetMode())
+	require.Equal(t, "config", files["config-link"].GetSymlinkTarget())
+	require.False(t, files["config-link"].GetDir())
+	// The link is recorded rather than the directory it points to.
+	require.NotContains(t, files, "config-link/app.conf")
+
+	require.NoError(t, replaceFile("config-link", []byte("#!/bin/sh"), fileMode(0o755)))
+	info, err := os.Lstat("config-link")
+	require.NoError(t, err)
+	require.True(t, info.Mode().IsRegular())
+	require.Equal(t, fs.FileMode(0o755), info.Mode().Perm())
+
+	require.NoError(t, writeSymlink("config-link", "config"))
+	target, err := os.Readlink(filepath.Join(dir, "config-link"))
+	require.NoError(t, err)
+	require.Equal(t, "config", target)
+}
+
+func TestPlanAndApplyDeletes(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	require.NoError(t, os.MkdirAll("old/nested", 0755))
+	require.NoError(t, os.MkdirAll("shared", 0755))
+	require.NoError(t, os.WriteFile("old/nested/a.txt", []byte("a"), 0644))
+	require.NoError(t, os.WriteFile("shared/b.txt", []byte("b"), 0644))
+	require.NoError(t, os.WriteFile("shared/untracked.txt", []byte("new"), 0644))
+	require.NoError(t, os.WriteFile("edited.txt", []byte("edited"), 0644))
+
+	baseFileMetadata := &pb.FileMetadata{Files: map[string]*pb.File{
+		"old":              {Dir: true},
+		"old/nested":       {Dir: true},
+		"old/nested/a.txt": {Hash: xxhash.Sum64String("a")},
+		"shared":           {Dir: true},
+		"shared/b.txt":     {Hash: xxhash.Sum64String("b")},
+		"edited.txt":       {Hash: xxhash.Sum64String("original")},
+	}}
+	localFileMetadata := readLocalFileList(jamignore.New(dir, nil))
+	remoteToLocalDiff := &pb.FileMetadataDiff{Diffs: map[string]*pb.FileMetadataDiff_FileDiff{
+		"old":                  {Type: pb.FileMetadataDiff_Delete},
+		"old/nested":           {Type: pb.FileMetadataDiff_Delete},
+		"old/nested/a.txt":     {Type: pb.FileMetadataDiff_Delete},
+		"shared":               {Type: pb.FileMetadataDiff_Delete},
+		"shared/b.txt":         {Type: pb.FileMetadataDiff_Delete},
+		"shared/untracked.txt": {Type: pb.FileMetadataDiff_Delete},
+		"edited.txt":           {Type: pb.FileMetadataDiff_Delete},
+	}}
+
+	deletes, kept := planDeletes(baseFileMetadata, localFileMetadata, remoteToLocalDiff)
+	require.Equal(t, []string{"old", "old/nested", "old/nested/a.txt", "shared", "shared/b.txt"}, deletes)
+	require.Equal(t, []string{"edited.txt"}, kept)
+
+	require.NoError(t, applyDeletes(deletes))
+	require.NoFileExists(t, "old/nested/a.txt")
+	require.NoDirExists(t, "old")
+	require.NoFileExists(t, "shared/b.txt")
+	// Untracked files keep their directory alive.
+	require.FileExists(t, "shared/untracked.txt")
+	require.FileExists(t, "edited.txt")
+}
+
+func TestConflictedFiles(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	merged, conflicts := diff.Merge3([]byte("a\nb\n"), []byte("a\nlocal\n"), []byte("a\nremote\n"))
+	require.Equal(t, 1, conflicts)
+	require.NoError(t, os.WriteFile("conflicted.txt", merged, 0644))
+	require.NoError(t, os.WriteFile("resolved.txt", []byte("a\nlocal\n"), 0644))
+	// Unchanged files are never uploaded, so their markers don't matter.
+	require.NoError(t, os.WriteFile("unchanged.txt", merged, 0644))
+
+	localToRemoteDiff := &pb.FileMetadataDiff{Diffs: map[string]*pb.FileMetadataDiff_FileDiff{
+		"conflicted.txt": {Type: pb.FileMetadataDiff_Update, File: &pb.File{}},
+		"resolved.txt":   {Type: pb.FileMetadataDiff_Update, File: &pb.File{}},
+		"unchanged.txt":  {Type: pb.FileMetadataDiff_NoOp, File: &pb.File{}},
+		"deleted.txt":    {Type: pb.FileMetadataDiff_Delete},
+	}}
+	conflicted, err := conflictedFiles(localToRemoteDiff)
+	require.NoError(t, err)
+	require.Equal(t, []string{"conflicted.txt"}, conflicted)
+
+	require.NoError(t, os.WriteFile("conflicted.txt", []byte("a\nlocal and remote\n"), 0644))
+	conflicted, err = conflictedFiles(localToRemoteDiff)
+	require.NoError(t, err)
+	require.Empty(t, conflicted)
+}
+
+func TestPullWithBinaryConflict(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	apiClient, projectId := newTestServer(t)
+	remote := jam.NewClient(apiClient, projectId, 0)
+	base := map[string]string{"a.txt": "one\n", "c.txt": "x\ny\nz\n", "b.bin": "\x00base"}
+	pushFiles(t, remote, base)
+	baseClient := jam.NewClient(apiClient, projectId, remote.ProjectConfig().GetCurrentChange())
+	pushFiles(t, remote, map[string]string{"a.txt": "two\n", "c.txt": "x\ny\nZ\n", "b.bin": "\x00remote"})
+
+	// a.txt only changed remotely, while c.txt and b.bin changed on both sides.
+	local := map[string]string{"a.txt": "one\n", "c.txt": "X\ny\nz\n", "b.bin": "\x00local"}
+	for path, content := range local {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	localToRemoteDiff := &pb.FileMetadataDiff{Diffs: map[string]*pb.FileMetadataDiff_FileDiff{
+		"a.txt": {Type: pb.FileMetadataDiff_NoOp, File: &pb.File{Hash: xxhash.Sum64String(local["a.txt"])}},
+		"c.txt": {Type: pb.FileMetadataDiff_Update, File: &pb.File{Hash: xxhash.Sum64String(local["c.txt"])}},
+		"b.bin": {Type: pb.FileMetadataDiff_Update, File: &pb.File{Hash: xxhash.Sum64String(local["b.bin"])}},
+	}}
+	remoteToLocalDiff := &pb.FileMetadataDiff{Diffs: map[string]*pb.FileMetadataDiff_FileDiff{
+		"a.txt": {Type: pb.FileMetadataDiff_Update, File: &pb.File{Hash: xxhash.Sum64String("two\n")}},
+		"c.txt": {Type: pb.FileMetadataDiff_Update, File: &pb.File{Hash: xxhash.Sum64String("x\ny\nZ\n")}},
+		"b.bin": {Type: pb.FileMetadataDiff_Update, File: &pb.File{Hash: xxhash.Sum64String("\x00remote")}},
+	}}
+
+	err = pull(baseClient, remote, localToRemoteDiff, remoteToLocalDiff, nil)
+	require.True(t, errors.Is(err, errJamdiffFound))
+
+	// Everything but the binary conflict is brought up to the remote change that
+	// .jamsync now points at, so pushing after the merge doesn't revert a.txt.
+	read := func(path string) string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(data)
+	}
+	require.Equal(t, "two\n", read("a.txt"))
+	require.Equal(t, "X\ny\nZ\n", read("c.txt"))
+	require.Equal(t, "\x00local", read("b.bin"))
+	require.Equal(t, "\x00remote", read("b.bin.jamdiff"))
+	config := &pb.ProjectConfig{}
+	require.NoError(t, proto.Unmarshal([]byte(read(".jamsync")), config))
+	require.Equal(t, remote.ProjectConfig().GetCurrentChange(), config.GetCurrentChange())
+}
+
+func newTestServer(t *testing.T) (pb.JamsyncAPIClient, uint64) {
+	t.Setenv("JAM_ENV", "local")
+
+	database := db.NewMemory()
+	projectId, err := database.AddProject("test", "test@jamsync.dev")
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterJamsyncAPIServer(grpcServer, server.NewJamsyncServer(database, server.MemoryStores()))
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewJamsyncAPIClient(conn), projectId
+}
+
+// pushFiles commits a change that uploads every file in files along with their file
+// list.
+func pushFiles(t *testing.T, c *jam.Client, files map[string]string) {
+	ctx := context.Background()
+	fileList := &pb.FileMetadata{Files: make(map[string]*pb.File)}
+	for path, content := range files {
+		fileList.Files[path] = &pb.File{Hash: xxhash.Sum64String(content)}
+	}
+	fileListData, err := proto.Marshal(fileList)
+	require.NoError(t, err)
+
+	require.NoError(t, c.CreateChange(""))
+	for path, content := range files {
+		require.NoError(t, c.UploadFile(ctx, path, bytes.NewReader([]byte(content))))
+	}
+	require.NoError(t, c.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileListData)))
+	require.NoError(t, c.CommitChange())
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+)
+
+func runTag(args []string) error {
+	flags := newFlagSet("tag")
+	message := flags.String("m", "", "message describing the tag")
+	change := flags.Uint64("change", 0, "change to tag, defaults to the local change")
+	remove := flags.Bool("delete", false, "delete the tag instead of creating it")
+	positional, err := parseFlags(flags, args, 0, 1)
+	if err != nil {
+		return err
+	}
+	if *remove && len(positional) == 0 {
+		return usageError{"-delete needs the name of a tag"}
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	if len(positional) == 0 {
+		return s.printTags()
+	}
+	if *remove {
+		_, err = s.api.DeleteTag(context.Background(), &pb.DeleteTagRequest{
+			ProjectId: s.remote.GetProjectId(),
+			Name:      positional[0],
+		})
+		if err != nil {
+			return err
+		}
+		log.Printf("Deleted tag %s\n", positional[0])
+		return nil
+	}
+
+	changeId := *change
+	if changeId == 0 {
+		changeId = s.client.ProjectConfig().GetCurrentChange()
+	}
+	tag, err := s.api.CreateTag(context.Background(), &pb.CreateTagRequest{
+		ProjectId: s.remote.GetProjectId(),
+		Name:      positional[0],
+		ChangeId:  changeId,
+		Message:   *message,
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Tagged change %d as %s\n", tag.GetChangeId(), tag.GetName())
+	return nil
+}
+
+func (s *session) printTags() error {
+	resp, err := s.api.ListTags(context.Background(), &pb.ListTagsRequest{
+		ProjectId: s.remote.GetProjectId(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, tag := range resp.GetTags() {
+		if tag.GetMessage() == "" {
+			fmt.Printf("%s at change %d\n", tag.GetName(), tag.GetChangeId())
+		} else {
+			fmt.Printf("%s at change %d: %s\n", tag.GetName(), tag.GetChangeId(), tag.GetMessage())
+		}
+	}
+	return nil
+}
+
+// runCheckout moves the local directory to a tag, or to a change given by its id.
+// Local changes are kept the same way a pull keeps them. Nothing can be pushed until
+// the local directory is pulled back up to the head of its branch.
+func runCheckout(args []string) error {
+	positional, err := parseFlags(newFlagSet("checkout"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	s, err := openSession()
+	if err != nil {
+		return err
+	}
+	defer s.closer()
+
+	changeId, err := strconv.ParseUint(positional[0], 10, 64)
+	if err != nil {
+		tag, err := s.api.GetTag(context.Background(), &pb.GetTagRequest{
+			ProjectId: s.remote.GetProjectId(),
+			Name:      positional[0],
+		})
+		if err != nil {
+			return err
+		}
+		changeId = tag.GetChangeId()
+	}
+
+	if changeId == s.client.ProjectConfig().GetCurrentChange() {
+		log.Printf("Already at change %d.\n", changeId)
+		return nil
+	}
+	plan, err := s.planPullTo(changeId)
+	if err != nil {
+		return err
+	}
+	err = s.applyPull(plan)
+	if err != nil {
+		return err
+	}
+	log.Printf("Checked out change %d, run jam pull to return to the head of branch %s\n", changeId, s.client.ProjectConfig().GetBranch())
+	return nil
+}
@@ -1,17 +1,25 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/zdgeier/jamsync/internal/server/opstore"
 	"github.com/zdgeier/jamsync/internal/server/server"
 )
 
 func main() {
-	closer, err := server.New()
+	retention, err := retention()
+	if err != nil {
+		log.Fatal(err)
+	}
+	closer, err := server.New(stores(), retention)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -25,3 +33,58 @@ func main() {
 
 	closer()
 }
+
+// stores keeps project data below jb, with operation data moved to an S3 compatible
+// bucket when JAM_S3_BUCKET is set. Any number of servers can share the bucket. An
+// upload that is resumed on a different server than it started on starts over.
+func stores() server.Stores {
+	stores := server.LocalStores("jb")
+	bucket := os.Getenv("JAM_S3_BUCKET")
+	if bucket == "" {
+		return stores
+	}
+
+	region := os.Getenv("JAM_S3_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+	endpoint := os.Getenv("JAM_S3_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+	stores.OpStore = opstore.NewS3Store(opstore.S3Config{
+		Endpoint:        endpoint,
+		Region:          region,
+		Bucket:          bucket,
+		AccessKeyId:     os.Getenv("JAM_S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("JAM_S3_SECRET_ACCESS_KEY"),
+		CacheDirectory:  os.Getenv("JAM_S3_CACHE_DIRECTORY"),
+	})
+	log.Println("Storing operation data in bucket", bucket, "at", endpoint)
+	return stores
+}
+
+// retention compacts projects down to the last JAM_RETAIN_CHANGES changes and the
+// changes committed within JAM_RETAIN_DURATION, such as 720h. Projects are never
+// compacted when neither is set.
+func retention() (server.RetentionPolicy, error) {
+	var policy server.RetentionPolicy
+	if changes := os.Getenv("JAM_RETAIN_CHANGES"); changes != "" {
+		keepChanges, err := strconv.Atoi(changes)
+		if err != nil || keepChanges < 0 {
+			return server.RetentionPolicy{}, fmt.Errorf("invalid JAM_RETAIN_CHANGES %q", changes)
+		}
+		policy.KeepChanges = keepChanges
+	}
+	if duration := os.Getenv("JAM_RETAIN_DURATION"); duration != "" {
+		keepDuration, err := time.ParseDuration(duration)
+		if err != nil {
+			return server.RetentionPolicy{}, fmt.Errorf("invalid JAM_RETAIN_DURATION: %w", err)
+		}
+		policy.KeepDuration = keepDuration
+	}
+	if policy.Enabled() {
+		log.Printf("Compacting projects down to the last %d changes and the changes of the last %s", policy.KeepChanges, policy.KeepDuration)
+	}
+	return policy, nil
+}
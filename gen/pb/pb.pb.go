@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProjectRole int32
+
+const (
+	ProjectRole_NoRole ProjectRole = 0
+	ProjectRole_Reader ProjectRole = 1
+	ProjectRole_Writer ProjectRole = 2
+	ProjectRole_Owner  ProjectRole = 3
+)
+
+// Enum value maps for ProjectRole.
+var (
+	ProjectRole_name = map[int32]string{
+		0: "NoRole",
+		1: "Reader",
+		2: "Writer",
+		3: "Owner",
+	}
+	ProjectRole_value = map[string]int32{
+		"NoRole": 0,
+		"Reader": 1,
+		"Writer": 2,
+		"Owner":  3,
+	}
+)
+
+func (x ProjectRole) Enum() *ProjectRole {
+	p := new(ProjectRole)
+	*p = x
+	return p
+}
+
+func (x ProjectRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_proto_enumTypes[0].Descriptor()
+}
+
+func (ProjectRole) Type() protoreflect.EnumType {
+	return &file_pb_proto_enumTypes[0]
+}
+
+func (x ProjectRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectRole.Descriptor instead.
+func (ProjectRole) EnumDescriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{0}
+}
+
 type FileMetadataDiff_Type int32
 
 const (
@@ -28,6 +80,8 @@ const (
 	FileMetadataDiff_Create FileMetadataDiff_Type = 1
 	FileMetadataDiff_Update FileMetadataDiff_Type = 2
 	FileMetadataDiff_Delete FileMetadataDiff_Type = 3
+	// The file has the content of from_path, which is deleted in the same diff.
+	FileMetadataDiff_Move FileMetadataDiff_Type = 4
 )
 
 // Enum value maps for FileMetadataDiff_Type.
@@ -37,12 +91,14 @@ var (
 		1: "Create",
 		2: "Update",
 		3: "Delete",
+		4: "Move",
 	}
 	FileMetadataDiff_Type_value = map[string]int32{
 		"NoOp":   0,
 		"Create": 1,
 		"Update": 2,
 		"Delete": 3,
+		"Move":   4,
 	}
 )
 
@@ -57,11 +113,11 @@ func (x FileMetadataDiff_Type) String() string {
 }
 
 func (FileMetadataDiff_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_proto_enumTypes[0].Descriptor()
+	return file_pb_proto_enumTypes[1].Descriptor()
 }
 
 func (FileMetadataDiff_Type) Type() protoreflect.EnumType {
-	return &file_pb_proto_enumTypes[0]
+	return &file_pb_proto_enumTypes[1]
 }
 
 func (x FileMetadataDiff_Type) Number() protoreflect.EnumNumber {
@@ -70,7 +126,53 @@ func (x FileMetadataDiff_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FileMetadataDiff_Type.Descriptor instead.
 func (FileMetadataDiff_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{3, 0}
+	return file_pb_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type ExportSnapshotRequest_Format int32
+
+const (
+	ExportSnapshotRequest_TarGz ExportSnapshotRequest_Format = 0
+	ExportSnapshotRequest_Zip   ExportSnapshotRequest_Format = 1
+)
+
+// Enum value maps for ExportSnapshotRequest_Format.
+var (
+	ExportSnapshotRequest_Format_name = map[int32]string{
+		0: "TarGz",
+		1: "Zip",
+	}
+	ExportSnapshotRequest_Format_value = map[string]int32{
+		"TarGz": 0,
+		"Zip":   1,
+	}
+)
+
+func (x ExportSnapshotRequest_Format) Enum() *ExportSnapshotRequest_Format {
+	p := new(ExportSnapshotRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportSnapshotRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportSnapshotRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportSnapshotRequest_Format) Type() protoreflect.EnumType {
+	return &file_pb_proto_enumTypes[2]
+}
+
+func (x ExportSnapshotRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportSnapshotRequest_Format.Descriptor instead.
+func (ExportSnapshotRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{8, 0}
 }
 
 type Operation_Type int32
@@ -109,11 +211,11 @@ func (x Operation_Type) String() string {
 }
 
 func (Operation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_proto_enumTypes[1].Descriptor()
+	return file_pb_proto_enumTypes[3].Descriptor()
 }
 
 func (Operation_Type) Type() protoreflect.EnumType {
-	return &file_pb_proto_enumTypes[1]
+	return &file_pb_proto_enumTypes[3]
 }
 
 func (x Operation_Type) Number() protoreflect.EnumNumber {
@@ -122,7 +224,7 @@ func (x Operation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Operation_Type.Descriptor instead.
 func (Operation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{13, 0}
+	return file_pb_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type ChangeStreamRequest struct {
@@ -265,6 +367,110 @@ func (*WriteOperationStreamResponse) Descriptor() ([]byte, []int) {
 	return file_pb_proto_rawDescGZIP(), []int{2}
 }
 
+type GetUploadStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	UploadId  string `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (x *GetUploadStatusRequest) Reset() {
+	*x = GetUploadStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUploadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusRequest) ProtoMessage() {}
+
+func (x *GetUploadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUploadStatusRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetUploadStatusRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// received_ops is the number of operations of the upload that the server has, which
+// is zero for uploads it does not know about.
+type GetUploadStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceivedOps uint64 `protobuf:"varint,1,opt,name=received_ops,json=receivedOps,proto3" json:"received_ops,omitempty"`
+}
+
+func (x *GetUploadStatusResponse) Reset() {
+	*x = GetUploadStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUploadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusResponse) ProtoMessage() {}
+
+func (x *GetUploadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUploadStatusResponse) GetReceivedOps() uint64 {
+	if x != nil {
+		return x.ReceivedOps
+	}
+	return 0
+}
+
 type FileMetadataDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -276,7 +482,7 @@ type FileMetadataDiff struct {
 func (x *FileMetadataDiff) Reset() {
 	*x = FileMetadataDiff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[3]
+		mi := &file_pb_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -289,7 +495,7 @@ func (x *FileMetadataDiff) String() string {
 func (*FileMetadataDiff) ProtoMessage() {}
 
 func (x *FileMetadataDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[3]
+	mi := &file_pb_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,7 +508,7 @@ func (x *FileMetadataDiff) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileMetadataDiff.ProtoReflect.Descriptor instead.
 func (*FileMetadataDiff) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{3}
+	return file_pb_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FileMetadataDiff) GetDiffs() map[string]*FileMetadataDiff_FileDiff {
@@ -325,7 +531,7 @@ type BlockHash struct {
 func (x *BlockHash) Reset() {
 	*x = BlockHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[4]
+		mi := &file_pb_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +544,7 @@ func (x *BlockHash) String() string {
 func (*BlockHash) ProtoMessage() {}
 
 func (x *BlockHash) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[4]
+	mi := &file_pb_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +557,7 @@ func (x *BlockHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockHash.ProtoReflect.Descriptor instead.
 func (*BlockHash) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{4}
+	return file_pb_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BlockHash) GetIndex() uint64 {
@@ -375,22 +581,25 @@ func (x *BlockHash) GetWeakHash() uint32 {
 	return 0
 }
 
+// Reads only see committed changes. With include_open_change set, change_id must be
+// an open change of the caller and what was uploaded to it so far is read as well.
 type ReadFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId   uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ChangeId    uint64                 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	PathHash    uint64                 `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
-	ModTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
-	BlockHashes []*BlockHash           `protobuf:"bytes,5,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	ProjectId         uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId          uint64                 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	PathHash          uint64                 `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	ModTime           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	BlockHashes       []*BlockHash           `protobuf:"bytes,5,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	IncludeOpenChange bool                   `protobuf:"varint,6,opt,name=include_open_change,json=includeOpenChange,proto3" json:"include_open_change,omitempty"`
 }
 
 func (x *ReadFileRequest) Reset() {
 	*x = ReadFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[5]
+		mi := &file_pb_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -403,7 +612,7 @@ func (x *ReadFileRequest) String() string {
 func (*ReadFileRequest) ProtoMessage() {}
 
 func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[5]
+	mi := &file_pb_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +625,7 @@ func (x *ReadFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileRequest.ProtoReflect.Descriptor instead.
 func (*ReadFileRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{5}
+	return file_pb_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReadFileRequest) GetProjectId() uint64 {
@@ -454,34 +663,43 @@ func (x *ReadFileRequest) GetBlockHashes() []*BlockHash {
 	return nil
 }
 
-type ReadBlockHashesRequest struct {
+func (x *ReadFileRequest) GetIncludeOpenChange() bool {
+	if x != nil {
+		return x.IncludeOpenChange
+	}
+	return false
+}
+
+// Exporting a snapshot streams an archive of every file in the file list at change_id,
+// or at the head of the main branch if it is 0, with directories, modification times
+// and modes preserved.
+type ExportSnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ChangeId  uint64                 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	PathHash  uint64                 `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
-	ModTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	ProjectId uint64                       `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId  uint64                       `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Format    ExportSnapshotRequest_Format `protobuf:"varint,3,opt,name=format,proto3,enum=pb.ExportSnapshotRequest_Format" json:"format,omitempty"`
 }
 
-func (x *ReadBlockHashesRequest) Reset() {
-	*x = ReadBlockHashesRequest{}
+func (x *ExportSnapshotRequest) Reset() {
+	*x = ExportSnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[6]
+		mi := &file_pb_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadBlockHashesRequest) String() string {
+func (x *ExportSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadBlockHashesRequest) ProtoMessage() {}
+func (*ExportSnapshotRequest) ProtoMessage() {}
 
-func (x *ReadBlockHashesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[6]
+func (x *ExportSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,64 +710,58 @@ func (x *ReadBlockHashesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadBlockHashesRequest.ProtoReflect.Descriptor instead.
-func (*ReadBlockHashesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ExportSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ReadBlockHashesRequest) GetProjectId() uint64 {
+func (x *ExportSnapshotRequest) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *ReadBlockHashesRequest) GetChangeId() uint64 {
+func (x *ExportSnapshotRequest) GetChangeId() uint64 {
 	if x != nil {
 		return x.ChangeId
 	}
 	return 0
 }
 
-func (x *ReadBlockHashesRequest) GetPathHash() uint64 {
-	if x != nil {
-		return x.PathHash
-	}
-	return 0
-}
-
-func (x *ReadBlockHashesRequest) GetModTime() *timestamppb.Timestamp {
+func (x *ExportSnapshotRequest) GetFormat() ExportSnapshotRequest_Format {
 	if x != nil {
-		return x.ModTime
+		return x.Format
 	}
-	return nil
+	return ExportSnapshotRequest_TarGz
 }
 
-type ReadBlockHashesResponse struct {
+// The archive is the data of every chunk in the order they were sent.
+type SnapshotChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHashes []*BlockHash `protobuf:"bytes,1,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ReadBlockHashesResponse) Reset() {
-	*x = ReadBlockHashesResponse{}
+func (x *SnapshotChunk) Reset() {
+	*x = SnapshotChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[7]
+		mi := &file_pb_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadBlockHashesResponse) String() string {
+func (x *SnapshotChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadBlockHashesResponse) ProtoMessage() {}
+func (*SnapshotChunk) ProtoMessage() {}
 
-func (x *ReadBlockHashesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[7]
+func (x *SnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,47 +772,47 @@ func (x *ReadBlockHashesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadBlockHashesResponse.ProtoReflect.Descriptor instead.
-func (*ReadBlockHashesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SnapshotChunk.ProtoReflect.Descriptor instead.
+func (*SnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ReadBlockHashesResponse) GetBlockHashes() []*BlockHash {
+func (x *SnapshotChunk) GetData() []byte {
 	if x != nil {
-		return x.BlockHashes
+		return x.Data
 	}
 	return nil
 }
 
-type OperationLocations struct {
+type ReadBlockHashesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId uint64                                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	OwnerId   string                                  `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	ChangeId  uint64                                  `protobuf:"varint,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	PathHash  uint64                                  `protobuf:"varint,4,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
-	OpLocs    []*OperationLocations_OperationLocation `protobuf:"bytes,5,rep,name=opLocs,proto3" json:"opLocs,omitempty"`
+	ProjectId         uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId          uint64                 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	PathHash          uint64                 `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	ModTime           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	IncludeOpenChange bool                   `protobuf:"varint,5,opt,name=include_open_change,json=includeOpenChange,proto3" json:"include_open_change,omitempty"`
 }
 
-func (x *OperationLocations) Reset() {
-	*x = OperationLocations{}
+func (x *ReadBlockHashesRequest) Reset() {
+	*x = ReadBlockHashesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[8]
+		mi := &file_pb_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationLocations) String() string {
+func (x *ReadBlockHashesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationLocations) ProtoMessage() {}
+func (*ReadBlockHashesRequest) ProtoMessage() {}
 
-func (x *OperationLocations) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[8]
+func (x *ReadBlockHashesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,59 +823,202 @@ func (x *OperationLocations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationLocations.ProtoReflect.Descriptor instead.
-func (*OperationLocations) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReadBlockHashesRequest.ProtoReflect.Descriptor instead.
+func (*ReadBlockHashesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *OperationLocations) GetProjectId() uint64 {
+func (x *ReadBlockHashesRequest) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *OperationLocations) GetOwnerId() string {
-	if x != nil {
-		return x.OwnerId
-	}
-	return ""
-}
-
-func (x *OperationLocations) GetChangeId() uint64 {
+func (x *ReadBlockHashesRequest) GetChangeId() uint64 {
 	if x != nil {
 		return x.ChangeId
 	}
 	return 0
 }
 
-func (x *OperationLocations) GetPathHash() uint64 {
+func (x *ReadBlockHashesRequest) GetPathHash() uint64 {
 	if x != nil {
 		return x.PathHash
 	}
 	return 0
 }
 
-func (x *OperationLocations) GetOpLocs() []*OperationLocations_OperationLocation {
+func (x *ReadBlockHashesRequest) GetModTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OpLocs
+		return x.ModTime
 	}
 	return nil
 }
 
-type CommitChangeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ChangeId  uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-}
+func (x *ReadBlockHashesRequest) GetIncludeOpenChange() bool {
+	if x != nil {
+		return x.IncludeOpenChange
+	}
+	return false
+}
+
+type ReadBlockHashesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHashes []*BlockHash `protobuf:"bytes,1,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+}
+
+func (x *ReadBlockHashesResponse) Reset() {
+	*x = ReadBlockHashesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadBlockHashesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadBlockHashesResponse) ProtoMessage() {}
+
+func (x *ReadBlockHashesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadBlockHashesResponse.ProtoReflect.Descriptor instead.
+func (*ReadBlockHashesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadBlockHashesResponse) GetBlockHashes() []*BlockHash {
+	if x != nil {
+		return x.BlockHashes
+	}
+	return nil
+}
+
+type OperationLocations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64                                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	OwnerId   string                                  `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ChangeId  uint64                                  `protobuf:"varint,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	PathHash  uint64                                  `protobuf:"varint,4,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	OpLocs    []*OperationLocations_OperationLocation `protobuf:"bytes,5,rep,name=opLocs,proto3" json:"opLocs,omitempty"`
+	// data_key is where the operations are in the OpStore. Zero means the path hash,
+	// where operations were written before each change had its own data.
+	DataKey uint64 `protobuf:"varint,6,opt,name=data_key,json=dataKey,proto3" json:"data_key,omitempty"`
+}
+
+func (x *OperationLocations) Reset() {
+	*x = OperationLocations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationLocations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationLocations) ProtoMessage() {}
+
+func (x *OperationLocations) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationLocations.ProtoReflect.Descriptor instead.
+func (*OperationLocations) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OperationLocations) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *OperationLocations) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *OperationLocations) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *OperationLocations) GetPathHash() uint64 {
+	if x != nil {
+		return x.PathHash
+	}
+	return 0
+}
+
+func (x *OperationLocations) GetOpLocs() []*OperationLocations_OperationLocation {
+	if x != nil {
+		return x.OpLocs
+	}
+	return nil
+}
+
+func (x *OperationLocations) GetDataKey() uint64 {
+	if x != nil {
+		return x.DataKey
+	}
+	return 0
+}
+
+// base_change_id is the change the client last synced with. When it is set on either
+// request, the commit is rejected with an Aborted error carrying a CommitConflict if
+// a change committed after the base touched any of the same paths. The value on the
+// commit takes precedence.
+type CommitChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId     uint64   `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	ProjectId    uint64   `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Message      string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Paths        []string `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`
+	BaseChangeId *uint64  `protobuf:"varint,5,opt,name=base_change_id,json=baseChangeId,proto3,oneof" json:"base_change_id,omitempty"`
+}
 
 func (x *CommitChangeRequest) Reset() {
 	*x = CommitChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[9]
+		mi := &file_pb_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +1031,7 @@ func (x *CommitChangeRequest) String() string {
 func (*CommitChangeRequest) ProtoMessage() {}
 
 func (x *CommitChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[9]
+	mi := &file_pb_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +1044,7 @@ func (x *CommitChangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitChangeRequest.ProtoReflect.Descriptor instead.
 func (*CommitChangeRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{9}
+	return file_pb_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CommitChangeRequest) GetChangeId() uint64 {
@@ -706,6 +1061,27 @@ func (x *CommitChangeRequest) GetProjectId() uint64 {
 	return 0
 }
 
+func (x *CommitChangeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitChangeRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *CommitChangeRequest) GetBaseChangeId() uint64 {
+	if x != nil && x.BaseChangeId != nil {
+		return *x.BaseChangeId
+	}
+	return 0
+}
+
 type CommitChangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -715,7 +1091,7 @@ type CommitChangeResponse struct {
 func (x *CommitChangeResponse) Reset() {
 	*x = CommitChangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[10]
+		mi := &file_pb_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +1104,7 @@ func (x *CommitChangeResponse) String() string {
 func (*CommitChangeResponse) ProtoMessage() {}
 
 func (x *CommitChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[10]
+	mi := &file_pb_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,34 +1117,35 @@ func (x *CommitChangeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitChangeResponse.ProtoReflect.Descriptor instead.
 func (*CommitChangeResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{10}
+	return file_pb_proto_rawDescGZIP(), []int{14}
 }
 
-type CreateChangeRequest struct {
+type AbortChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId  uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 }
 
-func (x *CreateChangeRequest) Reset() {
-	*x = CreateChangeRequest{}
+func (x *AbortChangeRequest) Reset() {
+	*x = AbortChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[11]
+		mi := &file_pb_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChangeRequest) String() string {
+func (x *AbortChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChangeRequest) ProtoMessage() {}
+func (*AbortChangeRequest) ProtoMessage() {}
 
-func (x *CreateChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[11]
+func (x *AbortChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,43 +1156,48 @@ func (x *CreateChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChangeRequest.ProtoReflect.Descriptor instead.
-func (*CreateChangeRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AbortChangeRequest.ProtoReflect.Descriptor instead.
+func (*AbortChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateChangeRequest) GetProjectId() uint64 {
+func (x *AbortChangeRequest) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-type CreateChangeResponse struct {
+func (x *AbortChangeRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+type AbortChangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 }
 
-func (x *CreateChangeResponse) Reset() {
-	*x = CreateChangeResponse{}
+func (x *AbortChangeResponse) Reset() {
+	*x = AbortChangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[12]
+		mi := &file_pb_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChangeResponse) String() string {
+func (x *AbortChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChangeResponse) ProtoMessage() {}
+func (*AbortChangeResponse) ProtoMessage() {}
 
-func (x *CreateChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[12]
+func (x *AbortChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,49 +1208,41 @@ func (x *CreateChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChangeResponse.ProtoReflect.Descriptor instead.
-func (*CreateChangeResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *CreateChangeResponse) GetChangeId() uint64 {
-	if x != nil {
-		return x.ChangeId
-	}
-	return 0
+// Deprecated: Use AbortChangeResponse.ProtoReflect.Descriptor instead.
+func (*AbortChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{16}
 }
 
-type Operation struct {
+// An empty branch is the main branch.
+type CreateChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId     uint64         `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ChangeId      uint64         `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
-	PathHash      uint64         `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
-	Type          Operation_Type `protobuf:"varint,4,opt,name=type,proto3,enum=pb.Operation_Type" json:"type,omitempty"`
-	BlockIndex    uint64         `protobuf:"varint,5,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
-	BlockIndexEnd uint64         `protobuf:"varint,6,opt,name=block_index_end,json=blockIndexEnd,proto3" json:"block_index_end,omitempty"`
-	Data          []byte         `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	ProjectId    uint64  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Message      string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hostname     string  `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	BaseChangeId *uint64 `protobuf:"varint,4,opt,name=base_change_id,json=baseChangeId,proto3,oneof" json:"base_change_id,omitempty"`
+	Branch       string  `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
 }
 
-func (x *Operation) Reset() {
-	*x = Operation{}
+func (x *CreateChangeRequest) Reset() {
+	*x = CreateChangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[13]
+		mi := &file_pb_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operation) String() string {
+func (x *CreateChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation) ProtoMessage() {}
+func (*CreateChangeRequest) ProtoMessage() {}
 
-func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[13]
+func (x *CreateChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,37 +1253,238 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
-func (*Operation) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateChangeRequest.ProtoReflect.Descriptor instead.
+func (*CreateChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Operation) GetProjectId() uint64 {
+func (x *CreateChangeRequest) GetProjectId() uint64 {
 	if x != nil {
 		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *Operation) GetChangeId() uint64 {
+func (x *CreateChangeRequest) GetMessage() string {
 	if x != nil {
-		return x.ChangeId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *Operation) GetPathHash() uint64 {
+func (x *CreateChangeRequest) GetHostname() string {
 	if x != nil {
-		return x.PathHash
+		return x.Hostname
 	}
-	return 0
+	return ""
 }
 
-func (x *Operation) GetType() Operation_Type {
-	if x != nil {
-		return x.Type
+func (x *CreateChangeRequest) GetBaseChangeId() uint64 {
+	if x != nil && x.BaseChangeId != nil {
+		return *x.BaseChangeId
 	}
-	return Operation_OpBlock
+	return 0
+}
+
+func (x *CreateChangeRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type CommitConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseChangeId uint64 `protobuf:"varint,1,opt,name=base_change_id,json=baseChangeId,proto3" json:"base_change_id,omitempty"`
+	// latest_change_id is the newest committed change that touched one of paths.
+	LatestChangeId uint64   `protobuf:"varint,2,opt,name=latest_change_id,json=latestChangeId,proto3" json:"latest_change_id,omitempty"`
+	Paths          []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *CommitConflict) Reset() {
+	*x = CommitConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitConflict) ProtoMessage() {}
+
+func (x *CommitConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitConflict.ProtoReflect.Descriptor instead.
+func (*CommitConflict) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CommitConflict) GetBaseChangeId() uint64 {
+	if x != nil {
+		return x.BaseChangeId
+	}
+	return 0
+}
+
+func (x *CommitConflict) GetLatestChangeId() uint64 {
+	if x != nil {
+		return x.LatestChangeId
+	}
+	return 0
+}
+
+func (x *CommitConflict) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type CreateChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (x *CreateChangeResponse) Reset() {
+	*x = CreateChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChangeResponse) ProtoMessage() {}
+
+func (x *CreateChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChangeResponse.ProtoReflect.Descriptor instead.
+func (*CreateChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateChangeResponse) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId     uint64         `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId      uint64         `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	PathHash      uint64         `protobuf:"varint,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	Type          Operation_Type `protobuf:"varint,4,opt,name=type,proto3,enum=pb.Operation_Type" json:"type,omitempty"`
+	BlockIndex    uint64         `protobuf:"varint,5,opt,name=block_index,json=blockIndex,proto3" json:"block_index,omitempty"`
+	BlockIndexEnd uint64         `protobuf:"varint,6,opt,name=block_index_end,json=blockIndexEnd,proto3" json:"block_index_end,omitempty"`
+	Data          []byte         `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// Set instead of data when the server has moved the data into its chunk store.
+	DataHash []byte `protobuf:"bytes,8,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+	// Operations sent with an upload_id are numbered from zero by seq, so that an
+	// interrupted upload can be resumed with GetUploadStatus. The upload ends with an
+	// OpHash operation carrying the xxhash of the uploaded file in content_hash, which
+	// the server checks before accepting the file.
+	UploadId    string `protobuf:"bytes,9,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Seq         uint64 `protobuf:"varint,10,opt,name=seq,proto3" json:"seq,omitempty"`
+	ContentHash uint64 `protobuf:"varint,11,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Operation) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *Operation) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *Operation) GetPathHash() uint64 {
+	if x != nil {
+		return x.PathHash
+	}
+	return 0
+}
+
+func (x *Operation) GetType() Operation_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Operation_OpBlock
 }
 
 func (x *Operation) GetBlockIndex() uint64 {
@@ -933,6 +1508,34 @@ func (x *Operation) GetData() []byte {
 	return nil
 }
 
+func (x *Operation) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
+	}
+	return nil
+}
+
+func (x *Operation) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *Operation) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Operation) GetContentHash() uint64 {
+	if x != nil {
+		return x.ContentHash
+	}
+	return 0
+}
+
 type File struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -941,12 +1544,17 @@ type File struct {
 	ModTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
 	Dir     bool                   `protobuf:"varint,2,opt,name=dir,proto3" json:"dir,omitempty"`
 	Hash    uint64                 `protobuf:"varint,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// POSIX permission bits, including setuid, setgid and sticky. Zero means unknown.
+	Mode uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Set for symbolic links, which have no content, modification time or mode. hash
+	// is the hash of the target.
+	SymlinkTarget string `protobuf:"bytes,5,opt,name=symlink_target,json=symlinkTarget,proto3" json:"symlink_target,omitempty"`
 }
 
 func (x *File) Reset() {
 	*x = File{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[14]
+		mi := &file_pb_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -959,7 +1567,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[14]
+	mi := &file_pb_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1580,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{14}
+	return file_pb_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *File) GetModTime() *timestamppb.Timestamp {
@@ -996,6 +1604,20 @@ func (x *File) GetHash() uint64 {
 	return 0
 }
 
+func (x *File) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *File) GetSymlinkTarget() string {
+	if x != nil {
+		return x.SymlinkTarget
+	}
+	return ""
+}
+
 type FileMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1007,7 +1629,7 @@ type FileMetadata struct {
 func (x *FileMetadata) Reset() {
 	*x = FileMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[15]
+		mi := &file_pb_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1020,7 +1642,7 @@ func (x *FileMetadata) String() string {
 func (*FileMetadata) ProtoMessage() {}
 
 func (x *FileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[15]
+	mi := &file_pb_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1033,7 +1655,7 @@ func (x *FileMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileMetadata.ProtoReflect.Descriptor instead.
 func (*FileMetadata) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{15}
+	return file_pb_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FileMetadata) GetFiles() map[string]*File {
@@ -1054,7 +1676,7 @@ type AddProjectRequest struct {
 func (x *AddProjectRequest) Reset() {
 	*x = AddProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[16]
+		mi := &file_pb_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1067,7 +1689,7 @@ func (x *AddProjectRequest) String() string {
 func (*AddProjectRequest) ProtoMessage() {}
 
 func (x *AddProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[16]
+	mi := &file_pb_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1702,7 @@ func (x *AddProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectRequest.ProtoReflect.Descriptor instead.
 func (*AddProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{16}
+	return file_pb_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddProjectRequest) GetProjectName() string {
@@ -1101,7 +1723,7 @@ type AddProjectResponse struct {
 func (x *AddProjectResponse) Reset() {
 	*x = AddProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[17]
+		mi := &file_pb_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1114,7 +1736,7 @@ func (x *AddProjectResponse) String() string {
 func (*AddProjectResponse) ProtoMessage() {}
 
 func (x *AddProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[17]
+	mi := &file_pb_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1749,7 @@ func (x *AddProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddProjectResponse.ProtoReflect.Descriptor instead.
 func (*AddProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{17}
+	return file_pb_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AddProjectResponse) GetProjectId() uint64 {
@@ -1141,12 +1763,14 @@ type ListUserProjectsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	IncludeArchived bool `protobuf:"varint,1,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
 }
 
 func (x *ListUserProjectsRequest) Reset() {
 	*x = ListUserProjectsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[18]
+		mi := &file_pb_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1159,7 +1783,7 @@ func (x *ListUserProjectsRequest) String() string {
 func (*ListUserProjectsRequest) ProtoMessage() {}
 
 func (x *ListUserProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[18]
+	mi := &file_pb_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1796,14 @@ func (x *ListUserProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{18}
+	return file_pb_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUserProjectsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
 }
 
 type ListUserProjectsResponse struct {
@@ -1186,7 +1817,7 @@ type ListUserProjectsResponse struct {
 func (x *ListUserProjectsResponse) Reset() {
 	*x = ListUserProjectsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[19]
+		mi := &file_pb_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1199,7 +1830,7 @@ func (x *ListUserProjectsResponse) String() string {
 func (*ListUserProjectsResponse) ProtoMessage() {}
 
 func (x *ListUserProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[19]
+	mi := &file_pb_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1843,7 @@ func (x *ListUserProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{19}
+	return file_pb_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListUserProjectsResponse) GetProjects() []*ListUserProjectsResponse_Project {
@@ -1222,29 +1853,32 @@ func (x *ListUserProjectsResponse) GetProjects() []*ListUserProjectsResponse_Pro
 	return nil
 }
 
-type ListProjectsRequest struct {
+type RenameProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProjectId   uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName string `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
+func (x *RenameProjectRequest) Reset() {
+	*x = RenameProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[20]
+		mi := &file_pb_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *RenameProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*RenameProjectRequest) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[20]
+func (x *RenameProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1255,36 +1889,48 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RenameProjectRequest.ProtoReflect.Descriptor instead.
+func (*RenameProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{27}
 }
 
-type ListProjectsResponse struct {
+func (x *RenameProjectRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RenameProjectRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+type RenameProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Projects []*ListProjectsResponse_Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
 }
 
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
+func (x *RenameProjectResponse) Reset() {
+	*x = RenameProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[21]
+		mi := &file_pb_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListProjectsResponse) String() string {
+func (x *RenameProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
+func (*RenameProjectResponse) ProtoMessage() {}
 
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[21]
+func (x *RenameProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,43 +1941,39 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *ListProjectsResponse) GetProjects() []*ListProjectsResponse_Project {
-	if x != nil {
-		return x.Projects
-	}
-	return nil
+// Deprecated: Use RenameProjectResponse.ProtoReflect.Descriptor instead.
+func (*RenameProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{28}
 }
 
-type UserInfoRequest struct {
+// Archived projects can still be read but not changed, and are only listed when
+// archived projects are asked for.
+type ArchiveProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Archived  bool   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
-func (x *UserInfoRequest) Reset() {
-	*x = UserInfoRequest{}
+func (x *ArchiveProjectRequest) Reset() {
+	*x = ArchiveProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[22]
+		mi := &file_pb_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserInfoRequest) String() string {
+func (x *ArchiveProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoRequest) ProtoMessage() {}
+func (*ArchiveProjectRequest) ProtoMessage() {}
 
-func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[22]
+func (x *ArchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,43 +1984,48 @@ func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
-func (*UserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ArchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UserInfoRequest) GetUsername() string {
+func (x *ArchiveProjectRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.Username
+		return x.ProjectId
 	}
-	return ""
+	return 0
 }
 
-type UserInfoResponse struct {
+func (x *ArchiveProjectRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 }
 
-func (x *UserInfoResponse) Reset() {
-	*x = UserInfoResponse{}
+func (x *ArchiveProjectResponse) Reset() {
+	*x = ArchiveProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[23]
+		mi := &file_pb_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserInfoResponse) String() string {
+func (x *ArchiveProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoResponse) ProtoMessage() {}
+func (*ArchiveProjectResponse) ProtoMessage() {}
 
-func (x *UserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[23]
+func (x *ArchiveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,43 +2036,37 @@ func (x *UserInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoResponse.ProtoReflect.Descriptor instead.
-func (*UserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *UserInfoResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use ArchiveProjectResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{30}
 }
 
-type CreateUserRequest struct {
+// Deleting a project removes all of its changes and data for good.
+type DeleteProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[24]
+		mi := &file_pb_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *DeleteProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*DeleteProjectRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[24]
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,41 +2077,41 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateUserRequest) GetUsername() string {
+func (x *DeleteProjectRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.Username
+		return x.ProjectId
 	}
-	return ""
+	return 0
 }
 
-type CreateUserResponse struct {
+type DeleteProjectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[25]
+		mi := &file_pb_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *DeleteProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*DeleteProjectResponse) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[25]
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,37 +2122,46 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{32}
 }
 
-type BrowseProjectRequest struct {
+// A branch is a named line of changes forked from the head of its parent branch. Its
+// head is the latest change committed to it, or the fork until there is one.
+// merge_base_change_id is the change of the branch that was last merged into the
+// parent, or the fork if it was never merged. The timestamp is when the branch was
+// created, which for the main branch is when the first change of the project was made.
+type BranchInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Parent            string                 `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	ForkChangeId      uint64                 `protobuf:"varint,3,opt,name=fork_change_id,json=forkChangeId,proto3" json:"fork_change_id,omitempty"`
+	HeadChangeId      uint64                 `protobuf:"varint,4,opt,name=head_change_id,json=headChangeId,proto3" json:"head_change_id,omitempty"`
+	MergeBaseChangeId uint64                 `protobuf:"varint,5,opt,name=merge_base_change_id,json=mergeBaseChangeId,proto3" json:"merge_base_change_id,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *BrowseProjectRequest) Reset() {
-	*x = BrowseProjectRequest{}
+func (x *BranchInfo) Reset() {
+	*x = BranchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[26]
+		mi := &file_pb_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BrowseProjectRequest) String() string {
+func (x *BranchInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowseProjectRequest) ProtoMessage() {}
+func (*BranchInfo) ProtoMessage() {}
 
-func (x *BrowseProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[26]
+func (x *BranchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,51 +2172,81 @@ func (x *BrowseProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowseProjectRequest.ProtoReflect.Descriptor instead.
-func (*BrowseProjectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use BranchInfo.ProtoReflect.Descriptor instead.
+func (*BranchInfo) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *BrowseProjectRequest) GetProjectName() string {
+func (x *BranchInfo) GetName() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BrowseProjectRequest) GetPath() string {
+func (x *BranchInfo) GetParent() string {
 	if x != nil {
-		return x.Path
+		return x.Parent
 	}
 	return ""
 }
 
-type BrowseProjectResponse struct {
+func (x *BranchInfo) GetForkChangeId() uint64 {
+	if x != nil {
+		return x.ForkChangeId
+	}
+	return 0
+}
+
+func (x *BranchInfo) GetHeadChangeId() uint64 {
+	if x != nil {
+		return x.HeadChangeId
+	}
+	return 0
+}
+
+func (x *BranchInfo) GetMergeBaseChangeId() uint64 {
+	if x != nil {
+		return x.MergeBaseChangeId
+	}
+	return 0
+}
+
+func (x *BranchInfo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// An empty parent is the main branch.
+type CreateBranchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Directories []string `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
-	Files       []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Parent    string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
 }
 
-func (x *BrowseProjectResponse) Reset() {
-	*x = BrowseProjectResponse{}
+func (x *CreateBranchRequest) Reset() {
+	*x = CreateBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[27]
+		mi := &file_pb_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BrowseProjectResponse) String() string {
+func (x *CreateBranchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowseProjectResponse) ProtoMessage() {}
+func (*CreateBranchRequest) ProtoMessage() {}
 
-func (x *BrowseProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[27]
+func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1577,50 +2257,57 @@ func (x *BrowseProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowseProjectResponse.ProtoReflect.Descriptor instead.
-func (*BrowseProjectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use CreateBranchRequest.ProtoReflect.Descriptor instead.
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *BrowseProjectResponse) GetDirectories() []string {
+func (x *CreateBranchRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.Directories
+		return x.ProjectId
 	}
-	return nil
+	return 0
 }
 
-func (x *BrowseProjectResponse) GetFiles() []string {
+func (x *CreateBranchRequest) GetName() string {
 	if x != nil {
-		return x.Files
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetCurrentChangeRequest struct {
+func (x *CreateBranchRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListBranchesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *GetCurrentChangeRequest) Reset() {
-	*x = GetCurrentChangeRequest{}
+func (x *ListBranchesRequest) Reset() {
+	*x = ListBranchesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[28]
+		mi := &file_pb_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCurrentChangeRequest) String() string {
+func (x *ListBranchesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentChangeRequest) ProtoMessage() {}
+func (*ListBranchesRequest) ProtoMessage() {}
 
-func (x *GetCurrentChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[28]
+func (x *ListBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,44 +2318,45 @@ func (x *GetCurrentChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentChangeRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentChangeRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListBranchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetCurrentChangeRequest) GetProjectName() string {
+func (x *ListBranchesRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.ProjectName
+		return x.ProjectId
 	}
-	return ""
+	return 0
 }
 
-type GetCurrentChangeResponse struct {
+// The main branch comes first, followed by the other branches in the order they were
+// created.
+type ListBranchesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChangeId  uint64                 `protobuf:"varint,1,opt,name=changeId,proto3" json:"changeId,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Branches []*BranchInfo `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
 }
 
-func (x *GetCurrentChangeResponse) Reset() {
-	*x = GetCurrentChangeResponse{}
+func (x *ListBranchesResponse) Reset() {
+	*x = ListBranchesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[29]
+		mi := &file_pb_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCurrentChangeResponse) String() string {
+func (x *ListBranchesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentChangeResponse) ProtoMessage() {}
+func (*ListBranchesResponse) ProtoMessage() {}
 
-func (x *GetCurrentChangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[29]
+func (x *ListBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,51 +2367,48 @@ func (x *GetCurrentChangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentChangeResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentChangeResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *GetCurrentChangeResponse) GetChangeId() uint64 {
-	if x != nil {
-		return x.ChangeId
-	}
-	return 0
+// Deprecated: Use ListBranchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetCurrentChangeResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListBranchesResponse) GetBranches() []*BranchInfo {
 	if x != nil {
-		return x.Timestamp
+		return x.Branches
 	}
 	return nil
 }
 
-type GetProjectConfigRequest struct {
+// Merging commits the paths that changed on a branch since its merge base to its
+// parent. It fails with an Aborted error carrying a CommitConflict if the parent
+// changed any of those paths differently in the meantime.
+type MergeBranchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	ProjectId   uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetProjectConfigRequest) Reset() {
-	*x = GetProjectConfigRequest{}
+func (x *MergeBranchRequest) Reset() {
+	*x = MergeBranchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[30]
+		mi := &file_pb_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProjectConfigRequest) String() string {
+func (x *MergeBranchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectConfigRequest) ProtoMessage() {}
+func (*MergeBranchRequest) ProtoMessage() {}
 
-func (x *GetProjectConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[30]
+func (x *MergeBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,51 +2419,59 @@ func (x *GetProjectConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use MergeBranchRequest.ProtoReflect.Descriptor instead.
+func (*MergeBranchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetProjectConfigRequest) GetProjectName() string {
+func (x *MergeBranchRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.ProjectName
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *MergeBranchRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetProjectConfigRequest) GetProjectId() uint64 {
+func (x *MergeBranchRequest) GetMessage() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type ProjectConfig struct {
+// change_id is the change that the merge was committed in, or the head of the parent
+// if it already had every change of the branch.
+type MergeBranchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectId     uint64 `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
-	CurrentChange uint64 `protobuf:"varint,2,opt,name=current_change,json=currentChange,proto3" json:"current_change,omitempty"`
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 }
 
-func (x *ProjectConfig) Reset() {
-	*x = ProjectConfig{}
+func (x *MergeBranchResponse) Reset() {
+	*x = MergeBranchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[31]
+		mi := &file_pb_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProjectConfig) String() string {
+func (x *MergeBranchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectConfig) ProtoMessage() {}
+func (*MergeBranchResponse) ProtoMessage() {}
 
-func (x *ProjectConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[31]
+func (x *MergeBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,50 +2482,49 @@ func (x *ProjectConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectConfig.ProtoReflect.Descriptor instead.
-func (*ProjectConfig) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ProjectConfig) GetProjectId() uint64 {
-	if x != nil {
-		return x.ProjectId
-	}
-	return 0
+// Deprecated: Use MergeBranchResponse.ProtoReflect.Descriptor instead.
+func (*MergeBranchResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ProjectConfig) GetCurrentChange() uint64 {
+func (x *MergeBranchResponse) GetChangeId() uint64 {
 	if x != nil {
-		return x.CurrentChange
+		return x.ChangeId
 	}
 	return 0
 }
 
-type ListCommittedChangesRequest struct {
+// A tag names a committed change, so that the state of the project at that change can
+// be found again. Tagged changes are never compacted away.
+type TagInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChangeId  uint64                 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *ListCommittedChangesRequest) Reset() {
-	*x = ListCommittedChangesRequest{}
+func (x *TagInfo) Reset() {
+	*x = TagInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[32]
+		mi := &file_pb_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCommittedChangesRequest) String() string {
+func (x *TagInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommittedChangesRequest) ProtoMessage() {}
+func (*TagInfo) ProtoMessage() {}
 
-func (x *ListCommittedChangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[32]
+func (x *TagInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,43 +2535,76 @@ func (x *ListCommittedChangesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommittedChangesRequest.ProtoReflect.Descriptor instead.
-func (*ListCommittedChangesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use TagInfo.ProtoReflect.Descriptor instead.
+func (*TagInfo) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ListCommittedChangesRequest) GetProjectName() string {
+func (x *TagInfo) GetName() string {
 	if x != nil {
-		return x.ProjectName
+		return x.Name
 	}
 	return ""
 }
 
-type ListCommittedChangesResponse struct {
+func (x *TagInfo) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *TagInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TagInfo) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *TagInfo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// A change_id of 0 tags the head of the main branch. Tag names can't be numbers, so
+// that they can be used wherever a change id can.
+type CreateTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChangeIds []uint64 `protobuf:"varint,1,rep,packed,name=change_ids,json=changeIds,proto3" json:"change_ids,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ChangeId  uint64 `protobuf:"varint,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ListCommittedChangesResponse) Reset() {
-	*x = ListCommittedChangesResponse{}
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[33]
+		mi := &file_pb_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCommittedChangesResponse) String() string {
+func (x *CreateTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommittedChangesResponse) ProtoMessage() {}
+func (*CreateTagRequest) ProtoMessage() {}
 
-func (x *ListCommittedChangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[33]
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,41 +2615,65 @@ func (x *ListCommittedChangesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommittedChangesResponse.ProtoReflect.Descriptor instead.
-func (*ListCommittedChangesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ListCommittedChangesResponse) GetChangeIds() []uint64 {
+func (x *CreateTagRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.ChangeIds
+		return x.ProjectId
 	}
-	return nil
+	return 0
 }
 
-type PingRequest struct {
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *CreateTagRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[34]
+		mi := &file_pb_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *GetTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*GetTagRequest) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[34]
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1935,34 +2684,50 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{41}
 }
 
-type PingResponse struct {
+func (x *GetTagRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[35]
+		mi := &file_pb_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *ListTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*ListTagsRequest) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[35]
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,37 +2738,44 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{42}
 }
 
-type FileMetadataDiff_FileDiff struct {
+func (x *ListTagsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+// Tags are in ascending order of change id.
+type ListTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type FileMetadataDiff_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.FileMetadataDiff_Type" json:"type,omitempty"`
-	File *File                 `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Tags []*TagInfo `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *FileMetadataDiff_FileDiff) Reset() {
-	*x = FileMetadataDiff_FileDiff{}
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[36]
+		mi := &file_pb_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileMetadataDiff_FileDiff) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMetadataDiff_FileDiff) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *FileMetadataDiff_FileDiff) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[36]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,51 +2786,44 @@ func (x *FileMetadataDiff_FileDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMetadataDiff_FileDiff.ProtoReflect.Descriptor instead.
-func (*FileMetadataDiff_FileDiff) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{3, 0}
-}
-
-func (x *FileMetadataDiff_FileDiff) GetType() FileMetadataDiff_Type {
-	if x != nil {
-		return x.Type
-	}
-	return FileMetadataDiff_NoOp
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *FileMetadataDiff_FileDiff) GetFile() *File {
+func (x *ListTagsResponse) GetTags() []*TagInfo {
 	if x != nil {
-		return x.File
+		return x.Tags
 	}
 	return nil
 }
 
-type OperationLocations_OperationLocation struct {
+type DeleteTagRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Length uint64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *OperationLocations_OperationLocation) Reset() {
-	*x = OperationLocations_OperationLocation{}
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[38]
+		mi := &file_pb_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationLocations_OperationLocation) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationLocations_OperationLocation) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *OperationLocations_OperationLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[38]
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,51 +2834,48 @@ func (x *OperationLocations_OperationLocation) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationLocations_OperationLocation.ProtoReflect.Descriptor instead.
-func (*OperationLocations_OperationLocation) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *OperationLocations_OperationLocation) GetOffset() uint64 {
+func (x *DeleteTagRequest) GetProjectId() uint64 {
 	if x != nil {
-		return x.Offset
+		return x.ProjectId
 	}
 	return 0
 }
 
-func (x *OperationLocations_OperationLocation) GetLength() uint64 {
+func (x *DeleteTagRequest) GetName() string {
 	if x != nil {
-		return x.Length
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type ListUserProjectsResponse_Project struct {
+type DeleteTagResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id   uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListUserProjectsResponse_Project) Reset() {
-	*x = ListUserProjectsResponse_Project{}
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[40]
+		mi := &file_pb_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserProjectsResponse_Project) String() string {
+func (x *DeleteTagResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserProjectsResponse_Project) ProtoMessage() {}
+func (*DeleteTagResponse) ProtoMessage() {}
 
-func (x *ListUserProjectsResponse_Project) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[40]
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,51 +2886,34 @@ func (x *ListUserProjectsResponse_Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserProjectsResponse_Project.ProtoReflect.Descriptor instead.
-func (*ListUserProjectsResponse_Project) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{19, 0}
-}
-
-func (x *ListUserProjectsResponse_Project) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ListUserProjectsResponse_Project) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{45}
 }
 
-type ListProjectsResponse_Project struct {
+type ListProjectsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id   uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListProjectsResponse_Project) Reset() {
-	*x = ListProjectsResponse_Project{}
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_proto_msgTypes[41]
+		mi := &file_pb_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListProjectsResponse_Project) String() string {
+func (x *ListProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse_Project) ProtoMessage() {}
+func (*ListProjectsRequest) ProtoMessage() {}
 
-func (x *ListProjectsResponse_Project) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_proto_msgTypes[41]
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,424 +2924,3626 @@ func (x *ListProjectsResponse_Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse_Project.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse_Project) Descriptor() ([]byte, []int) {
-	return file_pb_proto_rawDescGZIP(), []int{21, 0}
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListProjectsResponse_Project) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ListProjectsResponse_Project) GetId() uint64 {
+	Projects []*ListProjectsResponse_Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*ListProjectsResponse_Project {
 	if x != nil {
-		return x.Id
+		return x.Projects
+	}
+	return nil
+}
+
+type AddProjectMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64      `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Username  string      `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role      ProjectRole `protobuf:"varint,3,opt,name=role,proto3,enum=pb.ProjectRole" json:"role,omitempty"`
+}
+
+func (x *AddProjectMemberRequest) Reset() {
+	*x = AddProjectMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProjectMemberRequest) ProtoMessage() {}
+
+func (x *AddProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddProjectMemberRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
 	}
 	return 0
 }
 
-var File_pb_proto protoreflect.FileDescriptor
+func (x *AddProjectMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
 
-var file_pb_proto_rawDesc = []byte{
-	0x0a, 0x08, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x34, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x12, 0x35, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x1a,
-	0x57, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x2d, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x2e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x57, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x34, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x4f,
-	0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x03, 0x22, 0x5f, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
-	0x72, 0x6f, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x77,
-	0x65, 0x61, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x77, 0x65, 0x61, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61,
-	0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74,
-	0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0c,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xa8,
-	0x01, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x61,
-	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x40, 0x0a, 0x06, 0x6f, 0x70, 0x4c, 0x6f, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x4c,
-	0x6f, 0x63, 0x73, 0x1a, 0x43, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x51, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0xa8,
-	0x02, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74,
-	0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x26,
-	0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65, 0x6e,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x4f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f,
-	0x70, 0x48, 0x61, 0x73, 0x68, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x70, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x22, 0x63, 0x0a, 0x04, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x85,
-	0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x31, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x1a, 0x42, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x33,
-	0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b,
-	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x2d, 0x0a,
-	0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x2d, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x0f, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x4d, 0x0a, 0x14, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4f,
-	0x0a, 0x15, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
-	0x3c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x70, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x0d,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x22, 0x40, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x49, 0x64, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x32, 0xac, 0x07, 0x0a, 0x0a, 0x4a, 0x61, 0x6d, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x50,
-	0x49, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0d, 0x2e, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x70, 0x62,
-	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
-	0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01,
-	0x12, 0x42, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x35, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13,
-	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f,
-	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x7a, 0x64, 0x67, 0x65, 0x69, 0x65, 0x72, 0x2f, 0x6a, 0x61, 0x6d, 0x73, 0x79, 0x6e, 0x63, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AddProjectMemberRequest) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_NoRole
 }
 
-var (
-	file_pb_proto_rawDescOnce sync.Once
-	file_pb_proto_rawDescData = file_pb_proto_rawDesc
-)
+type AddProjectMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-func file_pb_proto_rawDescGZIP() []byte {
-	file_pb_proto_rawDescOnce.Do(func() {
-		file_pb_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_proto_rawDescData)
-	})
-	return file_pb_proto_rawDescData
+func (x *AddProjectMemberResponse) Reset() {
+	*x = AddProjectMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_pb_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pb_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
-var file_pb_proto_goTypes = []interface{}{
-	(FileMetadataDiff_Type)(0),                   // 0: pb.FileMetadataDiff.Type
-	(Operation_Type)(0),                          // 1: pb.Operation.Type
-	(*ChangeStreamRequest)(nil),                  // 2: pb.ChangeStreamRequest
-	(*ChangeStreamMessage)(nil),                  // 3: pb.ChangeStreamMessage
-	(*WriteOperationStreamResponse)(nil),         // 4: pb.WriteOperationStreamResponse
-	(*FileMetadataDiff)(nil),                     // 5: pb.FileMetadataDiff
-	(*BlockHash)(nil),                            // 6: pb.BlockHash
-	(*ReadFileRequest)(nil),                      // 7: pb.ReadFileRequest
-	(*ReadBlockHashesRequest)(nil),               // 8: pb.ReadBlockHashesRequest
-	(*ReadBlockHashesResponse)(nil),              // 9: pb.ReadBlockHashesResponse
-	(*OperationLocations)(nil),                   // 10: pb.OperationLocations
-	(*CommitChangeRequest)(nil),                  // 11: pb.CommitChangeRequest
-	(*CommitChangeResponse)(nil),                 // 12: pb.CommitChangeResponse
-	(*CreateChangeRequest)(nil),                  // 13: pb.CreateChangeRequest
-	(*CreateChangeResponse)(nil),                 // 14: pb.CreateChangeResponse
-	(*Operation)(nil),                            // 15: pb.Operation
-	(*File)(nil),                                 // 16: pb.File
-	(*FileMetadata)(nil),                         // 17: pb.FileMetadata
-	(*AddProjectRequest)(nil),                    // 18: pb.AddProjectRequest
-	(*AddProjectResponse)(nil),                   // 19: pb.AddProjectResponse
-	(*ListUserProjectsRequest)(nil),              // 20: pb.ListUserProjectsRequest
-	(*ListUserProjectsResponse)(nil),             // 21: pb.ListUserProjectsResponse
-	(*ListProjectsRequest)(nil),                  // 22: pb.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                 // 23: pb.ListProjectsResponse
-	(*UserInfoRequest)(nil),                      // 24: pb.UserInfoRequest
-	(*UserInfoResponse)(nil),                     // 25: pb.UserInfoResponse
-	(*CreateUserRequest)(nil),                    // 26: pb.CreateUserRequest
-	(*CreateUserResponse)(nil),                   // 27: pb.CreateUserResponse
-	(*BrowseProjectRequest)(nil),                 // 28: pb.BrowseProjectRequest
-	(*BrowseProjectResponse)(nil),                // 29: pb.BrowseProjectResponse
-	(*GetCurrentChangeRequest)(nil),              // 30: pb.GetCurrentChangeRequest
-	(*GetCurrentChangeResponse)(nil),             // 31: pb.GetCurrentChangeResponse
-	(*GetProjectConfigRequest)(nil),              // 32: pb.GetProjectConfigRequest
-	(*ProjectConfig)(nil),                        // 33: pb.ProjectConfig
-	(*ListCommittedChangesRequest)(nil),          // 34: pb.ListCommittedChangesRequest
-	(*ListCommittedChangesResponse)(nil),         // 35: pb.ListCommittedChangesResponse
-	(*PingRequest)(nil),                          // 36: pb.PingRequest
-	(*PingResponse)(nil),                         // 37: pb.PingResponse
-	(*FileMetadataDiff_FileDiff)(nil),            // 38: pb.FileMetadataDiff.FileDiff
-	nil,                                          // 39: pb.FileMetadataDiff.DiffsEntry
-	(*OperationLocations_OperationLocation)(nil), // 40: pb.OperationLocations.OperationLocation
-	nil,                                      // 41: pb.FileMetadata.FilesEntry
-	(*ListUserProjectsResponse_Project)(nil), // 42: pb.ListUserProjectsResponse.Project
-	(*ListProjectsResponse_Project)(nil),     // 43: pb.ListProjectsResponse.Project
-	(*timestamppb.Timestamp)(nil),            // 44: google.protobuf.Timestamp
+func (x *AddProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-var file_pb_proto_depIdxs = []int32{
-	39, // 0: pb.FileMetadataDiff.diffs:type_name -> pb.FileMetadataDiff.DiffsEntry
-	44, // 1: pb.ReadFileRequest.mod_time:type_name -> google.protobuf.Timestamp
-	6,  // 2: pb.ReadFileRequest.block_hashes:type_name -> pb.BlockHash
-	44, // 3: pb.ReadBlockHashesRequest.mod_time:type_name -> google.protobuf.Timestamp
-	6,  // 4: pb.ReadBlockHashesResponse.block_hashes:type_name -> pb.BlockHash
-	40, // 5: pb.OperationLocations.opLocs:type_name -> pb.OperationLocations.OperationLocation
-	1,  // 6: pb.Operation.type:type_name -> pb.Operation.Type
-	44, // 7: pb.File.mod_time:type_name -> google.protobuf.Timestamp
-	41, // 8: pb.FileMetadata.files:type_name -> pb.FileMetadata.FilesEntry
-	42, // 9: pb.ListUserProjectsResponse.projects:type_name -> pb.ListUserProjectsResponse.Project
-	43, // 10: pb.ListProjectsResponse.projects:type_name -> pb.ListProjectsResponse.Project
-	44, // 11: pb.GetCurrentChangeResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 12: pb.FileMetadataDiff.FileDiff.type:type_name -> pb.FileMetadataDiff.Type
-	16, // 13: pb.FileMetadataDiff.FileDiff.file:type_name -> pb.File
-	38, // 14: pb.FileMetadataDiff.DiffsEntry.value:type_name -> pb.FileMetadataDiff.FileDiff
-	16, // 15: pb.FileMetadata.FilesEntry.value:type_name -> pb.File
-	13, // 16: pb.JamsyncAPI.CreateChange:input_type -> pb.CreateChangeRequest
-	15, // 17: pb.JamsyncAPI.WriteOperationStream:input_type -> pb.Operation
-	11, // 18: pb.JamsyncAPI.CommitChange:input_type -> pb.CommitChangeRequest
-	8,  // 19: pb.JamsyncAPI.ReadBlockHashes:input_type -> pb.ReadBlockHashesRequest
-	7,  // 20: pb.JamsyncAPI.ReadFile:input_type -> pb.ReadFileRequest
-	2,  // 21: pb.JamsyncAPI.ChangeStream:input_type -> pb.ChangeStreamRequest
-	18, // 22: pb.JamsyncAPI.AddProject:input_type -> pb.AddProjectRequest
-	22, // 23: pb.JamsyncAPI.ListProjects:input_type -> pb.ListProjectsRequest
-	20, // 24: pb.JamsyncAPI.ListUserProjects:input_type -> pb.ListUserProjectsRequest
-	34, // 25: pb.JamsyncAPI.ListCommittedChanges:input_type -> pb.ListCommittedChangesRequest
-	32, // 26: pb.JamsyncAPI.GetProjectConfig:input_type -> pb.GetProjectConfigRequest
-	24, // 27: pb.JamsyncAPI.UserInfo:input_type -> pb.UserInfoRequest
-	26, // 28: pb.JamsyncAPI.CreateUser:input_type -> pb.CreateUserRequest
-	36, // 29: pb.JamsyncAPI.Ping:input_type -> pb.PingRequest
-	14, // 30: pb.JamsyncAPI.CreateChange:output_type -> pb.CreateChangeResponse
-	4,  // 31: pb.JamsyncAPI.WriteOperationStream:output_type -> pb.WriteOperationStreamResponse
-	12, // 32: pb.JamsyncAPI.CommitChange:output_type -> pb.CommitChangeResponse
-	9,  // 33: pb.JamsyncAPI.ReadBlockHashes:output_type -> pb.ReadBlockHashesResponse
-	15, // 34: pb.JamsyncAPI.ReadFile:output_type -> pb.Operation
-	3,  // 35: pb.JamsyncAPI.ChangeStream:output_type -> pb.ChangeStreamMessage
-	19, // 36: pb.JamsyncAPI.AddProject:output_type -> pb.AddProjectResponse
-	23, // 37: pb.JamsyncAPI.ListProjects:output_type -> pb.ListProjectsResponse
-	21, // 38: pb.JamsyncAPI.ListUserProjects:output_type -> pb.ListUserProjectsResponse
-	35, // 39: pb.JamsyncAPI.ListCommittedChanges:output_type -> pb.ListCommittedChangesResponse
-	33, // 40: pb.JamsyncAPI.GetProjectConfig:output_type -> pb.ProjectConfig
-	25, // 41: pb.JamsyncAPI.UserInfo:output_type -> pb.UserInfoResponse
-	27, // 42: pb.JamsyncAPI.CreateUser:output_type -> pb.CreateUserResponse
-	37, // 43: pb.JamsyncAPI.Ping:output_type -> pb.PingResponse
-	30, // [30:44] is the sub-list for method output_type
-	16, // [16:30] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+func (*AddProjectMemberResponse) ProtoMessage() {}
+
+func (x *AddProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func init() { file_pb_proto_init() }
-func file_pb_proto_init() {
-	if File_pb_proto != nil {
-		return
+// Deprecated: Use AddProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{49}
+}
+
+type RemoveProjectMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *RemoveProjectMemberRequest) Reset() {
+	*x = RemoveProjectMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeStreamRequest); i {
+}
+
+func (x *RemoveProjectMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberRequest) ProtoMessage() {}
+
+func (x *RemoveProjectMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveProjectMemberRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RemoveProjectMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type RemoveProjectMemberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveProjectMemberResponse) Reset() {
+	*x = RemoveProjectMemberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveProjectMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProjectMemberResponse) ProtoMessage() {}
+
+func (x *RemoveProjectMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProjectMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProjectMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{51}
+}
+
+type ListProjectMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *ListProjectMembersRequest) Reset() {
+	*x = ListProjectMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersRequest) ProtoMessage() {}
+
+func (x *ListProjectMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListProjectMembersRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ListProjectMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ListProjectMembersResponse_Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListProjectMembersResponse) Reset() {
+	*x = ListProjectMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListProjectMembersResponse) GetMembers() []*ListProjectMembersResponse_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type UserInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UserInfoRequest) Reset() {
+	*x = UserInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoRequest) ProtoMessage() {}
+
+func (x *UserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoRequest.ProtoReflect.Descriptor instead.
+func (*UserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UserInfoRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UserInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UserInfoResponse) Reset() {
+	*x = UserInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoResponse) ProtoMessage() {}
+
+func (x *UserInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoResponse.ProtoReflect.Descriptor instead.
+func (*UserInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UserInfoResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{57}
+}
+
+type BrowseProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BrowseProjectRequest) Reset() {
+	*x = BrowseProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowseProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseProjectRequest) ProtoMessage() {}
+
+func (x *BrowseProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseProjectRequest.ProtoReflect.Descriptor instead.
+func (*BrowseProjectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BrowseProjectRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *BrowseProjectRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BrowseProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Directories []string `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+	Files       []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *BrowseProjectResponse) Reset() {
+	*x = BrowseProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrowseProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseProjectResponse) ProtoMessage() {}
+
+func (x *BrowseProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseProjectResponse.ProtoReflect.Descriptor instead.
+func (*BrowseProjectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BrowseProjectResponse) GetDirectories() []string {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+func (x *BrowseProjectResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetCurrentChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+}
+
+func (x *GetCurrentChangeRequest) Reset() {
+	*x = GetCurrentChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentChangeRequest) ProtoMessage() {}
+
+func (x *GetCurrentChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentChangeRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetCurrentChangeRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+type GetCurrentChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId  uint64                 `protobuf:"varint,1,opt,name=changeId,proto3" json:"changeId,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetCurrentChangeResponse) Reset() {
+	*x = GetCurrentChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentChangeResponse) ProtoMessage() {}
+
+func (x *GetCurrentChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentChangeResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCurrentChangeResponse) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *GetCurrentChangeResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// An empty branch is the main branch.
+type GetProjectConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectId   uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Branch      string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *GetProjectConfigRequest) Reset() {
+	*x = GetProjectConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProjectConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectConfigRequest) ProtoMessage() {}
+
+func (x *GetProjectConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetProjectConfigRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *GetProjectConfigRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetProjectConfigRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+// current_change is the head of the branch.
+type ProjectConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId      uint64   `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	CurrentChange  uint64   `protobuf:"varint,2,opt,name=current_change,json=currentChange,proto3" json:"current_change,omitempty"`
+	IgnorePatterns []string `protobuf:"bytes,3,rep,name=ignore_patterns,json=ignorePatterns,proto3" json:"ignore_patterns,omitempty"`
+	Branch         string   `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *ProjectConfig) Reset() {
+	*x = ProjectConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectConfig) ProtoMessage() {}
+
+func (x *ProjectConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectConfig.ProtoReflect.Descriptor instead.
+func (*ProjectConfig) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ProjectConfig) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ProjectConfig) GetCurrentChange() uint64 {
+	if x != nil {
+		return x.CurrentChange
+	}
+	return 0
+}
+
+func (x *ProjectConfig) GetIgnorePatterns() []string {
+	if x != nil {
+		return x.IgnorePatterns
+	}
+	return nil
+}
+
+func (x *ProjectConfig) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type SetProjectIgnorePatternsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId      uint64   `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IgnorePatterns []string `protobuf:"bytes,2,rep,name=ignore_patterns,json=ignorePatterns,proto3" json:"ignore_patterns,omitempty"`
+}
+
+func (x *SetProjectIgnorePatternsRequest) Reset() {
+	*x = SetProjectIgnorePatternsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProjectIgnorePatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectIgnorePatternsRequest) ProtoMessage() {}
+
+func (x *SetProjectIgnorePatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectIgnorePatternsRequest.ProtoReflect.Descriptor instead.
+func (*SetProjectIgnorePatternsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetProjectIgnorePatternsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SetProjectIgnorePatternsRequest) GetIgnorePatterns() []string {
+	if x != nil {
+		return x.IgnorePatterns
+	}
+	return nil
+}
+
+type SetProjectIgnorePatternsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetProjectIgnorePatternsResponse) Reset() {
+	*x = SetProjectIgnorePatternsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProjectIgnorePatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProjectIgnorePatternsResponse) ProtoMessage() {}
+
+func (x *SetProjectIgnorePatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProjectIgnorePatternsResponse.ProtoReflect.Descriptor instead.
+func (*SetProjectIgnorePatternsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{65}
+}
+
+// Only the changes committed to branch are listed, or to the main branch if it is
+// empty.
+type ListCommittedChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectName string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectId   uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Branch      string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *ListCommittedChangesRequest) Reset() {
+	*x = ListCommittedChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommittedChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommittedChangesRequest) ProtoMessage() {}
+
+func (x *ListCommittedChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommittedChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommittedChangesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListCommittedChangesRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ListCommittedChangesRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListCommittedChangesRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type ListCommittedChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeIds []uint64 `protobuf:"varint,1,rep,packed,name=change_ids,json=changeIds,proto3" json:"change_ids,omitempty"`
+}
+
+func (x *ListCommittedChangesResponse) Reset() {
+	*x = ListCommittedChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommittedChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommittedChangesResponse) ProtoMessage() {}
+
+func (x *ListCommittedChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommittedChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommittedChangesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListCommittedChangesResponse) GetChangeIds() []uint64 {
+	if x != nil {
+		return x.ChangeIds
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{68}
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{69}
+}
+
+type ChangeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId        uint64                   `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	AuthorId        string                   `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Message         string                   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Hostname        string                   `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Timestamp       *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Committed       bool                     `protobuf:"varint,6,opt,name=committed,proto3" json:"committed,omitempty"`
+	CommitTimestamp *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=commit_timestamp,json=commitTimestamp,proto3" json:"commit_timestamp,omitempty"`
+	Files           []*ChangeInfo_ChangeFile `protobuf:"bytes,8,rep,name=files,proto3" json:"files,omitempty"`
+	Aborted         bool                     `protobuf:"varint,9,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Branch          string                   `protobuf:"bytes,10,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *ChangeInfo) Reset() {
+	*x = ChangeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeInfo) ProtoMessage() {}
+
+func (x *ChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeInfo.ProtoReflect.Descriptor instead.
+func (*ChangeInfo) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ChangeInfo) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *ChangeInfo) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ChangeInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChangeInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ChangeInfo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ChangeInfo) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ChangeInfo) GetCommitTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitTimestamp
+	}
+	return nil
+}
+
+func (x *ChangeInfo) GetFiles() []*ChangeInfo_ChangeFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ChangeInfo) GetAborted() bool {
+	if x != nil {
+		return x.Aborted
+	}
+	return false
+}
+
+func (x *ChangeInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type GetChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId  uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (x *GetChangeRequest) Reset() {
+	*x = GetChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangeRequest) ProtoMessage() {}
+
+func (x *GetChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangeRequest.ProtoReflect.Descriptor instead.
+func (*GetChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetChangeRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *GetChangeRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+type ListChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PageSize  uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken uint64 `protobuf:"varint,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListChangesRequest) Reset() {
+	*x = ListChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChangesRequest) ProtoMessage() {}
+
+func (x *ListChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListChangesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListChangesRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListChangesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListChangesRequest) GetPageToken() uint64 {
+	if x != nil {
+		return x.PageToken
+	}
+	return 0
+}
+
+type ListChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes       []*ChangeInfo `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken uint64        `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListChangesResponse) Reset() {
+	*x = ListChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChangesResponse) ProtoMessage() {}
+
+func (x *ListChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListChangesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListChangesResponse) GetChanges() []*ChangeInfo {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListChangesResponse) GetNextPageToken() uint64 {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return 0
+}
+
+// Restoring commits to the head of branch, the main branch if it is empty.
+type RestoreFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ChangeId  uint64 `protobuf:"varint,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Branch    string `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *RestoreFileRequest) Reset() {
+	*x = RestoreFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileRequest) ProtoMessage() {}
+
+func (x *RestoreFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFileRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFileRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RestoreFileRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RestoreFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RestoreFileRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *RestoreFileRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestoreFileRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type RestoreFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (x *RestoreFileResponse) Reset() {
+	*x = RestoreFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFileResponse) ProtoMessage() {}
+
+func (x *RestoreFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFileResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFileResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RestoreFileResponse) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+type RevertChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId  uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Branch    string `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *RevertChangeRequest) Reset() {
+	*x = RevertChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertChangeRequest) ProtoMessage() {}
+
+func (x *RevertChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertChangeRequest.ProtoReflect.Descriptor instead.
+func (*RevertChangeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RevertChangeRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *RevertChangeRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *RevertChangeRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevertChangeRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+type RevertChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+}
+
+func (x *RevertChangeResponse) Reset() {
+	*x = RevertChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertChangeResponse) ProtoMessage() {}
+
+func (x *RevertChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertChangeResponse.ProtoReflect.Descriptor instead.
+func (*RevertChangeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *RevertChangeResponse) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+// A to_change of 0 compares against the head of branch, or of the main branch if it is
+// empty.
+type DiffFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId  uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	FromChange uint64 `protobuf:"varint,3,opt,name=from_change,json=fromChange,proto3" json:"from_change,omitempty"`
+	ToChange   uint64 `protobuf:"varint,4,opt,name=to_change,json=toChange,proto3" json:"to_change,omitempty"`
+	Branch     string `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *DiffFileRequest) Reset() {
+	*x = DiffFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffFileRequest) ProtoMessage() {}
+
+func (x *DiffFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffFileRequest.ProtoReflect.Descriptor instead.
+func (*DiffFileRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DiffFileRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *DiffFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiffFileRequest) GetFromChange() uint64 {
+	if x != nil {
+		return x.FromChange
+	}
+	return 0
+}
+
+func (x *DiffFileRequest) GetToChange() uint64 {
+	if x != nil {
+		return x.ToChange
+	}
+	return 0
+}
+
+func (x *DiffFileRequest) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+// symlink is set when either side of the diff is a symbolic link, whose change is
+// described by diff instead of its content being compared.
+type DiffFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changed  bool   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	Binary   bool   `protobuf:"varint,2,opt,name=binary,proto3" json:"binary,omitempty"`
+	Diff     string `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	FromSize uint64 `protobuf:"varint,4,opt,name=from_size,json=fromSize,proto3" json:"from_size,omitempty"`
+	ToSize   uint64 `protobuf:"varint,5,opt,name=to_size,json=toSize,proto3" json:"to_size,omitempty"`
+	Symlink  bool   `protobuf:"varint,6,opt,name=symlink,proto3" json:"symlink,omitempty"`
+}
+
+func (x *DiffFileResponse) Reset() {
+	*x = DiffFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffFileResponse) ProtoMessage() {}
+
+func (x *DiffFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffFileResponse.ProtoReflect.Descriptor instead.
+func (*DiffFileResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DiffFileResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *DiffFileResponse) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
+func (x *DiffFileResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *DiffFileResponse) GetFromSize() uint64 {
+	if x != nil {
+		return x.FromSize
+	}
+	return 0
+}
+
+func (x *DiffFileResponse) GetToSize() uint64 {
+	if x != nil {
+		return x.ToSize
+	}
+	return 0
+}
+
+func (x *DiffFileResponse) GetSymlink() bool {
+	if x != nil {
+		return x.Symlink
+	}
+	return false
+}
+
+// CopyFileRequest writes the content that from_path_hash had before change_id to
+// to_path_hash in change_id. hash is the xxhash of the content the client expects, and
+// the copy fails with FailedPrecondition if the server has different content.
+type CopyFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId    uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ChangeId     uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	FromPathHash uint64 `protobuf:"varint,3,opt,name=from_path_hash,json=fromPathHash,proto3" json:"from_path_hash,omitempty"`
+	ToPathHash   uint64 `protobuf:"varint,4,opt,name=to_path_hash,json=toPathHash,proto3" json:"to_path_hash,omitempty"`
+	Hash         uint64 `protobuf:"varint,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *CopyFileRequest) Reset() {
+	*x = CopyFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFileRequest) ProtoMessage() {}
+
+func (x *CopyFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFileRequest.ProtoReflect.Descriptor instead.
+func (*CopyFileRequest) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CopyFileRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *CopyFileRequest) GetChangeId() uint64 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *CopyFileRequest) GetFromPathHash() uint64 {
+	if x != nil {
+		return x.FromPathHash
+	}
+	return 0
+}
+
+func (x *CopyFileRequest) GetToPathHash() uint64 {
+	if x != nil {
+		return x.ToPathHash
+	}
+	return 0
+}
+
+func (x *CopyFileRequest) GetHash() uint64 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+type CopyFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CopyFileResponse) Reset() {
+	*x = CopyFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFileResponse) ProtoMessage() {}
+
+func (x *CopyFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFileResponse.ProtoReflect.Descriptor instead.
+func (*CopyFileResponse) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{81}
+}
+
+type FileMetadataDiff_FileDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     FileMetadataDiff_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.FileMetadataDiff_Type" json:"type,omitempty"`
+	File     *File                 `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	FromPath string                `protobuf:"bytes,3,opt,name=from_path,json=fromPath,proto3" json:"from_path,omitempty"`
+}
+
+func (x *FileMetadataDiff_FileDiff) Reset() {
+	*x = FileMetadataDiff_FileDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileMetadataDiff_FileDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMetadataDiff_FileDiff) ProtoMessage() {}
+
+func (x *FileMetadataDiff_FileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMetadataDiff_FileDiff.ProtoReflect.Descriptor instead.
+func (*FileMetadataDiff_FileDiff) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *FileMetadataDiff_FileDiff) GetType() FileMetadataDiff_Type {
+	if x != nil {
+		return x.Type
+	}
+	return FileMetadataDiff_NoOp
+}
+
+func (x *FileMetadataDiff_FileDiff) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *FileMetadataDiff_FileDiff) GetFromPath() string {
+	if x != nil {
+		return x.FromPath
+	}
+	return ""
+}
+
+type OperationLocations_OperationLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length uint64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *OperationLocations_OperationLocation) Reset() {
+	*x = OperationLocations_OperationLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationLocations_OperationLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationLocations_OperationLocation) ProtoMessage() {}
+
+func (x *OperationLocations_OperationLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationLocations_OperationLocation.ProtoReflect.Descriptor instead.
+func (*OperationLocations_OperationLocation) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *OperationLocations_OperationLocation) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *OperationLocations_OperationLocation) GetLength() uint64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type ListUserProjectsResponse_Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id       uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Archived bool   `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+}
+
+func (x *ListUserProjectsResponse_Project) Reset() {
+	*x = ListUserProjectsResponse_Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserProjectsResponse_Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserProjectsResponse_Project) ProtoMessage() {}
+
+func (x *ListUserProjectsResponse_Project) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserProjectsResponse_Project.ProtoReflect.Descriptor instead.
+func (*ListUserProjectsResponse_Project) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ListUserProjectsResponse_Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListUserProjectsResponse_Project) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListUserProjectsResponse_Project) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ListProjectsResponse_Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id   uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListProjectsResponse_Project) Reset() {
+	*x = ListProjectsResponse_Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectsResponse_Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse_Project) ProtoMessage() {}
+
+func (x *ListProjectsResponse_Project) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse_Project.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse_Project) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ListProjectsResponse_Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProjectsResponse_Project) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListProjectMembersResponse_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string      `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	UserId   string      `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role     ProjectRole `protobuf:"varint,3,opt,name=role,proto3,enum=pb.ProjectRole" json:"role,omitempty"`
+}
+
+func (x *ListProjectMembersResponse_Member) Reset() {
+	*x = ListProjectMembersResponse_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProjectMembersResponse_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectMembersResponse_Member) ProtoMessage() {}
+
+func (x *ListProjectMembersResponse_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectMembersResponse_Member.ProtoReflect.Descriptor instead.
+func (*ListProjectMembersResponse_Member) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *ListProjectMembersResponse_Member) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ListProjectMembersResponse_Member) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListProjectMembersResponse_Member) GetRole() ProjectRole {
+	if x != nil {
+		return x.Role
+	}
+	return ProjectRole_NoRole
+}
+
+type ChangeInfo_ChangeFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PathHash uint64 `protobuf:"varint,1,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ChangeInfo_ChangeFile) Reset() {
+	*x = ChangeInfo_ChangeFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeInfo_ChangeFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeInfo_ChangeFile) ProtoMessage() {}
+
+func (x *ChangeInfo_ChangeFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeInfo_ChangeFile.ProtoReflect.Descriptor instead.
+func (*ChangeInfo_ChangeFile) Descriptor() ([]byte, []int) {
+	return file_pb_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *ChangeInfo_ChangeFile) GetPathHash() uint64 {
+	if x != nil {
+		return x.PathHash
+	}
+	return 0
+}
+
+func (x *ChangeInfo_ChangeFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+var File_pb_proto protoreflect.FileDescriptor
+
+var file_pb_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x34, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x6f, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x4f, 0x70, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x10, 0x46, 0x69, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x12, 0x35, 0x0a, 0x05,
+	0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66,
+	0x66, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69,
+	0x66, 0x66, 0x73, 0x1a, 0x74, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44,
+	0x69, 0x66, 0x66, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x57, 0x0a, 0x0a, 0x44, 0x69, 0x66,
+	0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x3e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f,
+	0x4f, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x6f, 0x76, 0x65,
+	0x10, 0x04, 0x22, 0x5f, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x6f,
+	0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x61, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x77, 0x65, 0x61, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x22, 0x83, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f,
+	0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x1c, 0x0a, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x61, 0x72, 0x47, 0x7a, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x5a, 0x69, 0x70, 0x10, 0x01, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd8, 0x01, 0x0a,
+	0x16, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x70, 0x65,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x40, 0x0a, 0x06, 0x6f, 0x70, 0x4c, 0x6f, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x4c, 0x6f, 0x63,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x4b, 0x65, 0x79, 0x1a, 0x43, 0x0a, 0x11,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x29, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x15, 0x0a,
+	0x13, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x61, 0x73,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0x33, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x97, 0x03, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x3d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x70, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x70, 0x48, 0x61, 0x73, 0x68, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x4f, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x22, 0x9e,
+	0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x69, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x79, 0x6d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x85, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x31, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x33, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x17,
+	0x0a, 0x15, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0a, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x6b, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x42, 0x61, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x60, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22,
+	0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x12, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x07, 0x54,
+	0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x42, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x22, 0x45, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x2d, 0x0a, 0x07, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x79, 0x0a, 0x17, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x57, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0xc1, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x62, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x0f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4d, 0x0a, 0x14, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4f,
+	0x0a, 0x15, 0x42, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0x3c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x70, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x73, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x69, 0x0a, 0x1f,
+	0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x22, 0x3d, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbd, 0x03, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2f, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x1a, 0x3d, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x74, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x4e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x22, 0x6f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x96, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22,
+	0x33, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x6f, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xa9, 0x01, 0x0a,
+	0x0f, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x6f, 0x70, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x3c, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e,
+	0x6f, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x10, 0x03, 0x32, 0x9d, 0x13, 0x0a, 0x0a, 0x4a,
+	0x61, 0x6d, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x50, 0x49, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x14,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x20, 0x2e, 0x70, 0x62, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x40, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64,
+	0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1b,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x41, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x06, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x67, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43,
+	0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
+	0x12, 0x23, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x7a, 0x64, 0x67, 0x65, 0x69, 0x65, 0x72,
+	0x2f, 0x6a, 0x61, 0x6d, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pb_proto_rawDescOnce sync.Once
+	file_pb_proto_rawDescData = file_pb_proto_rawDesc
+)
+
+func file_pb_proto_rawDescGZIP() []byte {
+	file_pb_proto_rawDescOnce.Do(func() {
+		file_pb_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_proto_rawDescData)
+	})
+	return file_pb_proto_rawDescData
+}
+
+var file_pb_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pb_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_pb_proto_goTypes = []interface{}{
+	(ProjectRole)(0),                             // 0: pb.ProjectRole
+	(FileMetadataDiff_Type)(0),                   // 1: pb.FileMetadataDiff.Type
+	(ExportSnapshotRequest_Format)(0),            // 2: pb.ExportSnapshotRequest.Format
+	(Operation_Type)(0),                          // 3: pb.Operation.Type
+	(*ChangeStreamRequest)(nil),                  // 4: pb.ChangeStreamRequest
+	(*ChangeStreamMessage)(nil),                  // 5: pb.ChangeStreamMessage
+	(*WriteOperationStreamResponse)(nil),         // 6: pb.WriteOperationStreamResponse
+	(*GetUploadStatusRequest)(nil),               // 7: pb.GetUploadStatusRequest
+	(*GetUploadStatusResponse)(nil),              // 8: pb.GetUploadStatusResponse
+	(*FileMetadataDiff)(nil),                     // 9: pb.FileMetadataDiff
+	(*BlockHash)(nil),                            // 10: pb.BlockHash
+	(*ReadFileRequest)(nil),                      // 11: pb.ReadFileRequest
+	(*ExportSnapshotRequest)(nil),                // 12: pb.ExportSnapshotRequest
+	(*SnapshotChunk)(nil),                        // 13: pb.SnapshotChunk
+	(*ReadBlockHashesRequest)(nil),               // 14: pb.ReadBlockHashesRequest
+	(*ReadBlockHashesResponse)(nil),              // 15: pb.ReadBlockHashesResponse
+	(*OperationLocations)(nil),                   // 16: pb.OperationLocations
+	(*CommitChangeRequest)(nil),                  // 17: pb.CommitChangeRequest
+	(*CommitChangeResponse)(nil),                 // 18: pb.CommitChangeResponse
+	(*AbortChangeRequest)(nil),                   // 19: pb.AbortChangeRequest
+	(*AbortChangeResponse)(nil),                  // 20: pb.AbortChangeResponse
+	(*CreateChangeRequest)(nil),                  // 21: pb.CreateChangeRequest
+	(*CommitConflict)(nil),                       // 22: pb.CommitConflict
+	(*CreateChangeResponse)(nil),                 // 23: pb.CreateChangeResponse
+	(*Operation)(nil),                            // 24: pb.Operation
+	(*File)(nil),                                 // 25: pb.File
+	(*FileMetadata)(nil),                         // 26: pb.FileMetadata
+	(*AddProjectRequest)(nil),                    // 27: pb.AddProjectRequest
+	(*AddProjectResponse)(nil),                   // 28: pb.AddProjectResponse
+	(*ListUserProjectsRequest)(nil),              // 29: pb.ListUserProjectsRequest
+	(*ListUserProjectsResponse)(nil),             // 30: pb.ListUserProjectsResponse
+	(*RenameProjectRequest)(nil),                 // 31: pb.RenameProjectRequest
+	(*RenameProjectResponse)(nil),                // 32: pb.RenameProjectResponse
+	(*ArchiveProjectRequest)(nil),                // 33: pb.ArchiveProjectRequest
+	(*ArchiveProjectResponse)(nil),               // 34: pb.ArchiveProjectResponse
+	(*DeleteProjectRequest)(nil),                 // 35: pb.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),                // 36: pb.DeleteProjectResponse
+	(*BranchInfo)(nil),                           // 37: pb.BranchInfo
+	(*CreateBranchRequest)(nil),                  // 38: pb.CreateBranchRequest
+	(*ListBranchesRequest)(nil),                  // 39: pb.ListBranchesRequest
+	(*ListBranchesResponse)(nil),                 // 40: pb.ListBranchesResponse
+	(*MergeBranchRequest)(nil),                   // 41: pb.MergeBranchRequest
+	(*MergeBranchResponse)(nil),                  // 42: pb.MergeBranchResponse
+	(*TagInfo)(nil),                              // 43: pb.TagInfo
+	(*CreateTagRequest)(nil),                     // 44: pb.CreateTagRequest
+	(*GetTagRequest)(nil),                        // 45: pb.GetTagRequest
+	(*ListTagsRequest)(nil),                      // 46: pb.ListTagsRequest
+	(*ListTagsResponse)(nil),                     // 47: pb.ListTagsResponse
+	(*DeleteTagRequest)(nil),                     // 48: pb.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                    // 49: pb.DeleteTagResponse
+	(*ListProjectsRequest)(nil),                  // 50: pb.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                 // 51: pb.ListProjectsResponse
+	(*AddProjectMemberRequest)(nil),              // 52: pb.AddProjectMemberRequest
+	(*AddProjectMemberResponse)(nil),             // 53: pb.AddProjectMemberResponse
+	(*RemoveProjectMemberRequest)(nil),           // 54: pb.RemoveProjectMemberRequest
+	(*RemoveProjectMemberResponse)(nil),          // 55: pb.RemoveProjectMemberResponse
+	(*ListProjectMembersRequest)(nil),            // 56: pb.ListProjectMembersRequest
+	(*ListProjectMembersResponse)(nil),           // 57: pb.ListProjectMembersResponse
+	(*UserInfoRequest)(nil),                      // 58: pb.UserInfoRequest
+	(*UserInfoResponse)(nil),                     // 59: pb.UserInfoResponse
+	(*CreateUserRequest)(nil),                    // 60: pb.CreateUserRequest
+	(*CreateUserResponse)(nil),                   // 61: pb.CreateUserResponse
+	(*BrowseProjectRequest)(nil),                 // 62: pb.BrowseProjectRequest
+	(*BrowseProjectResponse)(nil),                // 63: pb.BrowseProjectResponse
+	(*GetCurrentChangeRequest)(nil),              // 64: pb.GetCurrentChangeRequest
+	(*GetCurrentChangeResponse)(nil),             // 65: pb.GetCurrentChangeResponse
+	(*GetProjectConfigRequest)(nil),              // 66: pb.GetProjectConfigRequest
+	(*ProjectConfig)(nil),                        // 67: pb.ProjectConfig
+	(*SetProjectIgnorePatternsRequest)(nil),      // 68: pb.SetProjectIgnorePatternsRequest
+	(*SetProjectIgnorePatternsResponse)(nil),     // 69: pb.SetProjectIgnorePatternsResponse
+	(*ListCommittedChangesRequest)(nil),          // 70: pb.ListCommittedChangesRequest
+	(*ListCommittedChangesResponse)(nil),         // 71: pb.ListCommittedChangesResponse
+	(*PingRequest)(nil),                          // 72: pb.PingRequest
+	(*PingResponse)(nil),                         // 73: pb.PingResponse
+	(*ChangeInfo)(nil),                           // 74: pb.ChangeInfo
+	(*GetChangeRequest)(nil),                     // 75: pb.GetChangeRequest
+	(*ListChangesRequest)(nil),                   // 76: pb.ListChangesRequest
+	(*ListChangesResponse)(nil),                  // 77: pb.ListChangesResponse
+	(*RestoreFileRequest)(nil),                   // 78: pb.RestoreFileRequest
+	(*RestoreFileResponse)(nil),                  // 79: pb.RestoreFileResponse
+	(*RevertChangeRequest)(nil),                  // 80: pb.RevertChangeRequest
+	(*RevertChangeResponse)(nil),                 // 81: pb.RevertChangeResponse
+	(*DiffFileRequest)(nil),                      // 82: pb.DiffFileRequest
+	(*DiffFileResponse)(nil),                     // 83: pb.DiffFileResponse
+	(*CopyFileRequest)(nil),                      // 84: pb.CopyFileRequest
+	(*CopyFileResponse)(nil),                     // 85: pb.CopyFileResponse
+	(*FileMetadataDiff_FileDiff)(nil),            // 86: pb.FileMetadataDiff.FileDiff
+	nil,                                          // 87: pb.FileMetadataDiff.DiffsEntry
+	(*OperationLocations_OperationLocation)(nil), // 88: pb.OperationLocations.OperationLocation
+	nil,                                      // 89: pb.FileMetadata.FilesEntry
+	(*ListUserProjectsResponse_Project)(nil), // 90: pb.ListUserProjectsResponse.Project
+	(*ListProjectsResponse_Project)(nil),     // 91: pb.ListProjectsResponse.Project
+	(*ListProjectMembersResponse_Member)(nil), // 92: pb.ListProjectMembersResponse.Member
+	(*ChangeInfo_ChangeFile)(nil),             // 93: pb.ChangeInfo.ChangeFile
+	(*timestamppb.Timestamp)(nil),             // 94: google.protobuf.Timestamp
+}
+var file_pb_proto_depIdxs = []int32{
+	87, // 0: pb.FileMetadataDiff.diffs:type_name -> pb.FileMetadataDiff.DiffsEntry
+	94, // 1: pb.ReadFileRequest.mod_time:type_name -> google.protobuf.Timestamp
+	10, // 2: pb.ReadFileRequest.block_hashes:type_name -> pb.BlockHash
+	2,  // 3: pb.ExportSnapshotRequest.format:type_name -> pb.ExportSnapshotRequest.Format
+	94, // 4: pb.ReadBlockHashesRequest.mod_time:type_name -> google.protobuf.Timestamp
+	10, // 5: pb.ReadBlockHashesResponse.block_hashes:type_name -> pb.BlockHash
+	88, // 6: pb.OperationLocations.opLocs:type_name -> pb.OperationLocations.OperationLocation
+	3,  // 7: pb.Operation.type:type_name -> pb.Operation.Type
+	94, // 8: pb.File.mod_time:type_name -> google.protobuf.Timestamp
+	89, // 9: pb.FileMetadata.files:type_name -> pb.FileMetadata.FilesEntry
+	90, // 10: pb.ListUserProjectsResponse.projects:type_name -> pb.ListUserProjectsResponse.Project
+	94, // 11: pb.BranchInfo.timestamp:type_name -> google.protobuf.Timestamp
+	37, // 12: pb.ListBranchesResponse.branches:type_name -> pb.BranchInfo
+	94, // 13: pb.TagInfo.timestamp:type_name -> google.protobuf.Timestamp
+	43, // 14: pb.ListTagsResponse.tags:type_name -> pb.TagInfo
+	91, // 15: pb.ListProjectsResponse.projects:type_name -> pb.ListProjectsResponse.Project
+	0,  // 16: pb.AddProjectMemberRequest.role:type_name -> pb.ProjectRole
+	92, // 17: pb.ListProjectMembersResponse.members:type_name -> pb.ListProjectMembersResponse.Member
+	94, // 18: pb.GetCurrentChangeResponse.timestamp:type_name -> google.protobuf.Timestamp
+	94, // 19: pb.ChangeInfo.timestamp:type_name -> google.protobuf.Timestamp
+	94, // 20: pb.ChangeInfo.commit_timestamp:type_name -> google.protobuf.Timestamp
+	93, // 21: pb.ChangeInfo.files:type_name -> pb.ChangeInfo.ChangeFile
+	74, // 22: pb.ListChangesResponse.changes:type_name -> pb.ChangeInfo
+	1,  // 23: pb.FileMetadataDiff.FileDiff.type:type_name -> pb.FileMetadataDiff.Type
+	25, // 24: pb.FileMetadataDiff.FileDiff.file:type_name -> pb.File
+	86, // 25: pb.FileMetadataDiff.DiffsEntry.value:type_name -> pb.FileMetadataDiff.FileDiff
+	25, // 26: pb.FileMetadata.FilesEntry.value:type_name -> pb.File
+	0,  // 27: pb.ListProjectMembersResponse.Member.role:type_name -> pb.ProjectRole
+	21, // 28: pb.JamsyncAPI.CreateChange:input_type -> pb.CreateChangeRequest
+	24, // 29: pb.JamsyncAPI.WriteOperationStream:input_type -> pb.Operation
+	7,  // 30: pb.JamsyncAPI.GetUploadStatus:input_type -> pb.GetUploadStatusRequest
+	17, // 31: pb.JamsyncAPI.CommitChange:input_type -> pb.CommitChangeRequest
+	19, // 32: pb.JamsyncAPI.AbortChange:input_type -> pb.AbortChangeRequest
+	14, // 33: pb.JamsyncAPI.ReadBlockHashes:input_type -> pb.ReadBlockHashesRequest
+	11, // 34: pb.JamsyncAPI.ReadFile:input_type -> pb.ReadFileRequest
+	4,  // 35: pb.JamsyncAPI.ChangeStream:input_type -> pb.ChangeStreamRequest
+	12, // 36: pb.JamsyncAPI.ExportSnapshot:input_type -> pb.ExportSnapshotRequest
+	27, // 37: pb.JamsyncAPI.AddProject:input_type -> pb.AddProjectRequest
+	50, // 38: pb.JamsyncAPI.ListProjects:input_type -> pb.ListProjectsRequest
+	29, // 39: pb.JamsyncAPI.ListUserProjects:input_type -> pb.ListUserProjectsRequest
+	31, // 40: pb.JamsyncAPI.RenameProject:input_type -> pb.RenameProjectRequest
+	33, // 41: pb.JamsyncAPI.ArchiveProject:input_type -> pb.ArchiveProjectRequest
+	35, // 42: pb.JamsyncAPI.DeleteProject:input_type -> pb.DeleteProjectRequest
+	38, // 43: pb.JamsyncAPI.CreateBranch:input_type -> pb.CreateBranchRequest
+	39, // 44: pb.JamsyncAPI.ListBranches:input_type -> pb.ListBranchesRequest
+	41, // 45: pb.JamsyncAPI.MergeBranch:input_type -> pb.MergeBranchRequest
+	44, // 46: pb.JamsyncAPI.CreateTag:input_type -> pb.CreateTagRequest
+	45, // 47: pb.JamsyncAPI.GetTag:input_type -> pb.GetTagRequest
+	46, // 48: pb.JamsyncAPI.ListTags:input_type -> pb.ListTagsRequest
+	48, // 49: pb.JamsyncAPI.DeleteTag:input_type -> pb.DeleteTagRequest
+	70, // 50: pb.JamsyncAPI.ListCommittedChanges:input_type -> pb.ListCommittedChangesRequest
+	75, // 51: pb.JamsyncAPI.GetChange:input_type -> pb.GetChangeRequest
+	76, // 52: pb.JamsyncAPI.ListChanges:input_type -> pb.ListChangesRequest
+	78, // 53: pb.JamsyncAPI.RestoreFile:input_type -> pb.RestoreFileRequest
+	80, // 54: pb.JamsyncAPI.RevertChange:input_type -> pb.RevertChangeRequest
+	82, // 55: pb.JamsyncAPI.DiffFile:input_type -> pb.DiffFileRequest
+	84, // 56: pb.JamsyncAPI.CopyFile:input_type -> pb.CopyFileRequest
+	66, // 57: pb.JamsyncAPI.GetProjectConfig:input_type -> pb.GetProjectConfigRequest
+	52, // 58: pb.JamsyncAPI.AddProjectMember:input_type -> pb.AddProjectMemberRequest
+	54, // 59: pb.JamsyncAPI.RemoveProjectMember:input_type -> pb.RemoveProjectMemberRequest
+	56, // 60: pb.JamsyncAPI.ListProjectMembers:input_type -> pb.ListProjectMembersRequest
+	68, // 61: pb.JamsyncAPI.SetProjectIgnorePatterns:input_type -> pb.SetProjectIgnorePatternsRequest
+	58, // 62: pb.JamsyncAPI.UserInfo:input_type -> pb.UserInfoRequest
+	60, // 63: pb.JamsyncAPI.CreateUser:input_type -> pb.CreateUserRequest
+	72, // 64: pb.JamsyncAPI.Ping:input_type -> pb.PingRequest
+	23, // 65: pb.JamsyncAPI.CreateChange:output_type -> pb.CreateChangeResponse
+	6,  // 66: pb.JamsyncAPI.WriteOperationStream:output_type -> pb.WriteOperationStreamResponse
+	8,  // 67: pb.JamsyncAPI.GetUploadStatus:output_type -> pb.GetUploadStatusResponse
+	18, // 68: pb.JamsyncAPI.CommitChange:output_type -> pb.CommitChangeResponse
+	20, // 69: pb.JamsyncAPI.AbortChange:output_type -> pb.AbortChangeResponse
+	15, // 70: pb.JamsyncAPI.ReadBlockHashes:output_type -> pb.ReadBlockHashesResponse
+	24, // 71: pb.JamsyncAPI.ReadFile:output_type -> pb.Operation
+	5,  // 72: pb.JamsyncAPI.ChangeStream:output_type -> pb.ChangeStreamMessage
+	13, // 73: pb.JamsyncAPI.ExportSnapshot:output_type -> pb.SnapshotChunk
+	28, // 74: pb.JamsyncAPI.AddProject:output_type -> pb.AddProjectResponse
+	51, // 75: pb.JamsyncAPI.ListProjects:output_type -> pb.ListProjectsResponse
+	30, // 76: pb.JamsyncAPI.ListUserProjects:output_type -> pb.ListUserProjectsResponse
+	32, // 77: pb.JamsyncAPI.RenameProject:output_type -> pb.RenameProjectResponse
+	34, // 78: pb.JamsyncAPI.ArchiveProject:output_type -> pb.ArchiveProjectResponse
+	36, // 79: pb.JamsyncAPI.DeleteProject:output_type -> pb.DeleteProjectResponse
+	37, // 80: pb.JamsyncAPI.CreateBranch:output_type -> pb.BranchInfo
+	40, // 81: pb.JamsyncAPI.ListBranches:output_type -> pb.ListBranchesResponse
+	42, // 82: pb.JamsyncAPI.MergeBranch:output_type -> pb.MergeBranchResponse
+	43, // 83: pb.JamsyncAPI.CreateTag:output_type -> pb.TagInfo
+	43, // 84: pb.JamsyncAPI.GetTag:output_type -> pb.TagInfo
+	47, // 85: pb.JamsyncAPI.ListTags:output_type -> pb.ListTagsResponse
+	49, // 86: pb.JamsyncAPI.DeleteTag:output_type -> pb.DeleteTagResponse
+	71, // 87: pb.JamsyncAPI.ListCommittedChanges:output_type -> pb.ListCommittedChangesResponse
+	74, // 88: pb.JamsyncAPI.GetChange:output_type -> pb.ChangeInfo
+	77, // 89: pb.JamsyncAPI.ListChanges:output_type -> pb.ListChangesResponse
+	79, // 90: pb.JamsyncAPI.RestoreFile:output_type -> pb.RestoreFileResponse
+	81, // 91: pb.JamsyncAPI.RevertChange:output_type -> pb.RevertChangeResponse
+	83, // 92: pb.JamsyncAPI.DiffFile:output_type -> pb.DiffFileResponse
+	85, // 93: pb.JamsyncAPI.CopyFile:output_type -> pb.CopyFileResponse
+	67, // 94: pb.JamsyncAPI.GetProjectConfig:output_type -> pb.ProjectConfig
+	53, // 95: pb.JamsyncAPI.AddProjectMember:output_type -> pb.AddProjectMemberResponse
+	55, // 96: pb.JamsyncAPI.RemoveProjectMember:output_type -> pb.RemoveProjectMemberResponse
+	57, // 97: pb.JamsyncAPI.ListProjectMembers:output_type -> pb.ListProjectMembersResponse
+	69, // 98: pb.JamsyncAPI.SetProjectIgnorePatterns:output_type -> pb.SetProjectIgnorePatternsResponse
+	59, // 99: pb.JamsyncAPI.UserInfo:output_type -> pb.UserInfoResponse
+	61, // 100: pb.JamsyncAPI.CreateUser:output_type -> pb.CreateUserResponse
+	73, // 101: pb.JamsyncAPI.Ping:output_type -> pb.PingResponse
+	65, // [65:102] is the sub-list for method output_type
+	28, // [28:65] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_pb_proto_init() }
+func file_pb_proto_init() {
+	if File_pb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStreamMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteOperationStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUploadStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUploadStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMetadataDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBlockHashesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadBlockHashesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationLocations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2607,8 +6554,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeStreamMessage); i {
+		file_pb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2619,8 +6566,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteOperationStreamResponse); i {
+		file_pb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2631,8 +6578,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileMetadataDiff); i {
+		file_pb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2643,8 +6590,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHash); i {
+		file_pb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2655,8 +6602,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFileRequest); i {
+		file_pb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2667,8 +6614,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadBlockHashesRequest); i {
+		file_pb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBranchesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2679,8 +6626,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadBlockHashesResponse); i {
+		file_pb_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBranchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2691,8 +6638,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationLocations); i {
+		file_pb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeBranchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2703,8 +6650,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitChangeRequest); i {
+		file_pb_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2715,8 +6662,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommitChangeResponse); i {
+		file_pb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2727,8 +6674,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChangeRequest); i {
+		file_pb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2739,8 +6686,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateChangeResponse); i {
+		file_pb_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2751,8 +6698,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
+		file_pb_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2763,8 +6710,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*File); i {
+		file_pb_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2775,8 +6722,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileMetadata); i {
+		file_pb_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2787,8 +6734,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectRequest); i {
+		file_pb_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2799,8 +6746,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProjectResponse); i {
+		file_pb_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2811,8 +6758,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserProjectsRequest); i {
+		file_pb_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2823,8 +6770,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserProjectsResponse); i {
+		file_pb_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProjectMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2835,8 +6782,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsRequest); i {
+		file_pb_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProjectMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2847,8 +6794,8 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsResponse); i {
+		file_pb_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveProjectMemberResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2859,7 +6806,31 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserInfoRequest); i {
 			case 0:
 				return &v.state
@@ -2871,7 +6842,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserInfoResponse); i {
 			case 0:
 				return &v.state
@@ -2883,7 +6854,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserRequest); i {
 			case 0:
 				return &v.state
@@ -2895,7 +6866,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserResponse); i {
 			case 0:
 				return &v.state
@@ -2907,7 +6878,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BrowseProjectRequest); i {
 			case 0:
 				return &v.state
@@ -2919,7 +6890,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BrowseProjectResponse); i {
 			case 0:
 				return &v.state
@@ -2931,7 +6902,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCurrentChangeRequest); i {
 			case 0:
 				return &v.state
@@ -2943,7 +6914,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCurrentChangeResponse); i {
 			case 0:
 				return &v.state
@@ -2955,7 +6926,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetProjectConfigRequest); i {
 			case 0:
 				return &v.state
@@ -2967,7 +6938,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProjectConfig); i {
 			case 0:
 				return &v.state
@@ -2979,7 +6950,31 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProjectIgnorePatternsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProjectIgnorePatternsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCommittedChangesRequest); i {
 			case 0:
 				return &v.state
@@ -2991,7 +6986,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListCommittedChangesResponse); i {
 			case 0:
 				return &v.state
@@ -3003,7 +6998,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingRequest); i {
 			case 0:
 				return &v.state
@@ -3015,7 +7010,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PingResponse); i {
 			case 0:
 				return &v.state
@@ -3027,7 +7022,151 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileMetadataDiff_FileDiff); i {
 			case 0:
 				return &v.state
@@ -3039,7 +7178,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OperationLocations_OperationLocation); i {
 			case 0:
 				return &v.state
@@ -3051,7 +7190,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListUserProjectsResponse_Project); i {
 			case 0:
 				return &v.state
@@ -3063,7 +7202,7 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
-		file_pb_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_pb_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListProjectsResponse_Project); i {
 			case 0:
 				return &v.state
@@ -3075,14 +7214,40 @@ func file_pb_proto_init() {
 				return nil
 			}
 		}
+		file_pb_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectMembersResponse_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeInfo_ChangeFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_pb_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_pb_proto_msgTypes[17].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   42,
+			NumEnums:      4,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,15 +24,38 @@ const _ = grpc.SupportPackageIsVersion7
 type JamsyncAPIClient interface {
 	CreateChange(ctx context.Context, in *CreateChangeRequest, opts ...grpc.CallOption) (*CreateChangeResponse, error)
 	WriteOperationStream(ctx context.Context, opts ...grpc.CallOption) (JamsyncAPI_WriteOperationStreamClient, error)
+	GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error)
 	CommitChange(ctx context.Context, in *CommitChangeRequest, opts ...grpc.CallOption) (*CommitChangeResponse, error)
+	AbortChange(ctx context.Context, in *AbortChangeRequest, opts ...grpc.CallOption) (*AbortChangeResponse, error)
 	ReadBlockHashes(ctx context.Context, in *ReadBlockHashesRequest, opts ...grpc.CallOption) (*ReadBlockHashesResponse, error)
 	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (JamsyncAPI_ReadFileClient, error)
 	ChangeStream(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (JamsyncAPI_ChangeStreamClient, error)
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (JamsyncAPI_ExportSnapshotClient, error)
 	AddProject(ctx context.Context, in *AddProjectRequest, opts ...grpc.CallOption) (*AddProjectResponse, error)
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	ListUserProjects(ctx context.Context, in *ListUserProjectsRequest, opts ...grpc.CallOption) (*ListUserProjectsResponse, error)
+	RenameProject(ctx context.Context, in *RenameProjectRequest, opts ...grpc.CallOption) (*RenameProjectResponse, error)
+	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error)
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
+	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error)
+	MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*TagInfo, error)
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*TagInfo, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
 	ListCommittedChanges(ctx context.Context, in *ListCommittedChangesRequest, opts ...grpc.CallOption) (*ListCommittedChangesResponse, error)
+	GetChange(ctx context.Context, in *GetChangeRequest, opts ...grpc.CallOption) (*ChangeInfo, error)
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	RestoreFile(ctx context.Context, in *RestoreFileRequest, opts ...grpc.CallOption) (*RestoreFileResponse, error)
+	RevertChange(ctx context.Context, in *RevertChangeRequest, opts ...grpc.CallOption) (*RevertChangeResponse, error)
+	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*CopyFileResponse, error)
 	GetProjectConfig(ctx context.Context, in *GetProjectConfigRequest, opts ...grpc.CallOption) (*ProjectConfig, error)
+	AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*AddProjectMemberResponse, error)
+	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
+	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
+	SetProjectIgnorePatterns(ctx context.Context, in *SetProjectIgnorePatternsRequest, opts ...grpc.CallOption) (*SetProjectIgnorePatternsResponse, error)
 	UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoResponse, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
@@ -89,6 +112,15 @@ func (x *jamsyncAPIWriteOperationStreamClient) CloseAndRecv() (*WriteOperationSt
 	return m, nil
 }
 
+func (c *jamsyncAPIClient) GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error) {
+	out := new(GetUploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/GetUploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jamsyncAPIClient) CommitChange(ctx context.Context, in *CommitChangeRequest, opts ...grpc.CallOption) (*CommitChangeResponse, error) {
 	out := new(CommitChangeResponse)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/CommitChange", in, out, opts...)
@@ -98,6 +130,15 @@ func (c *jamsyncAPIClient) CommitChange(ctx context.Context, in *CommitChangeReq
 	return out, nil
 }
 
+func (c *jamsyncAPIClient) AbortChange(ctx context.Context, in *AbortChangeRequest, opts ...grpc.CallOption) (*AbortChangeResponse, error) {
+	out := new(AbortChangeResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/AbortChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jamsyncAPIClient) ReadBlockHashes(ctx context.Context, in *ReadBlockHashesRequest, opts ...grpc.CallOption) (*ReadBlockHashesResponse, error) {
 	out := new(ReadBlockHashesResponse)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ReadBlockHashes", in, out, opts...)
@@ -171,6 +212,38 @@ func (x *jamsyncAPIChangeStreamClient) Recv() (*ChangeStreamMessage, error) {
 	return m, nil
 }
 
+func (c *jamsyncAPIClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (JamsyncAPI_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JamsyncAPI_ServiceDesc.Streams[3], "/pb.JamsyncAPI/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jamsyncAPIExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JamsyncAPI_ExportSnapshotClient interface {
+	Recv() (*SnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type jamsyncAPIExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *jamsyncAPIExportSnapshotClient) Recv() (*SnapshotChunk, error) {
+	m := new(SnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *jamsyncAPIClient) AddProject(ctx context.Context, in *AddProjectRequest, opts ...grpc.CallOption) (*AddProjectResponse, error) {
 	out := new(AddProjectResponse)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/AddProject", in, out, opts...)
@@ -198,6 +271,96 @@ func (c *jamsyncAPIClient) ListUserProjects(ctx context.Context, in *ListUserPro
 	return out, nil
 }
 
+func (c *jamsyncAPIClient) RenameProject(ctx context.Context, in *RenameProjectRequest, opts ...grpc.CallOption) (*RenameProjectResponse, error) {
+	out := new(RenameProjectResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/RenameProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error) {
+	out := new(ArchiveProjectResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ArchiveProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error) {
+	out := new(BranchInfo)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/CreateBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error) {
+	out := new(ListBranchesResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ListBranches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) MergeBranch(ctx context.Context, in *MergeBranchRequest, opts ...grpc.CallOption) (*MergeBranchResponse, error) {
+	out := new(MergeBranchResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/MergeBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*TagInfo, error) {
+	out := new(TagInfo)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/GetTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jamsyncAPIClient) ListCommittedChanges(ctx context.Context, in *ListCommittedChangesRequest, opts ...grpc.CallOption) (*ListCommittedChangesResponse, error) {
 	out := new(ListCommittedChangesResponse)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ListCommittedChanges", in, out, opts...)
@@ -207,6 +370,60 @@ func (c *jamsyncAPIClient) ListCommittedChanges(ctx context.Context, in *ListCom
 	return out, nil
 }
 
+func (c *jamsyncAPIClient) GetChange(ctx context.Context, in *GetChangeRequest, opts ...grpc.CallOption) (*ChangeInfo, error) {
+	out := new(ChangeInfo)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/GetChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ListChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) RestoreFile(ctx context.Context, in *RestoreFileRequest, opts ...grpc.CallOption) (*RestoreFileResponse, error) {
+	out := new(RestoreFileResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/RestoreFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) RevertChange(ctx context.Context, in *RevertChangeRequest, opts ...grpc.CallOption) (*RevertChangeResponse, error) {
+	out := new(RevertChangeResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/RevertChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error) {
+	out := new(DiffFileResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/DiffFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*CopyFileResponse, error) {
+	out := new(CopyFileResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/CopyFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jamsyncAPIClient) GetProjectConfig(ctx context.Context, in *GetProjectConfigRequest, opts ...grpc.CallOption) (*ProjectConfig, error) {
 	out := new(ProjectConfig)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/GetProjectConfig", in, out, opts...)
@@ -216,6 +433,42 @@ func (c *jamsyncAPIClient) GetProjectConfig(ctx context.Context, in *GetProjectC
 	return out, nil
 }
 
+func (c *jamsyncAPIClient) AddProjectMember(ctx context.Context, in *AddProjectMemberRequest, opts ...grpc.CallOption) (*AddProjectMemberResponse, error) {
+	out := new(AddProjectMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/AddProjectMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error) {
+	out := new(RemoveProjectMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/RemoveProjectMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
+	out := new(ListProjectMembersResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/ListProjectMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jamsyncAPIClient) SetProjectIgnorePatterns(ctx context.Context, in *SetProjectIgnorePatternsRequest, opts ...grpc.CallOption) (*SetProjectIgnorePatternsResponse, error) {
+	out := new(SetProjectIgnorePatternsResponse)
+	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/SetProjectIgnorePatterns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jamsyncAPIClient) UserInfo(ctx context.Context, in *UserInfoRequest, opts ...grpc.CallOption) (*UserInfoResponse, error) {
 	out := new(UserInfoResponse)
 	err := c.cc.Invoke(ctx, "/pb.JamsyncAPI/UserInfo", in, out, opts...)
@@ -249,15 +502,38 @@ func (c *jamsyncAPIClient) Ping(ctx context.Context, in *PingRequest, opts ...gr
 type JamsyncAPIServer interface {
 	CreateChange(context.Context, *CreateChangeRequest) (*CreateChangeResponse, error)
 	WriteOperationStream(JamsyncAPI_WriteOperationStreamServer) error
+	GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error)
 	CommitChange(context.Context, *CommitChangeRequest) (*CommitChangeResponse, error)
+	AbortChange(context.Context, *AbortChangeRequest) (*AbortChangeResponse, error)
 	ReadBlockHashes(context.Context, *ReadBlockHashesRequest) (*ReadBlockHashesResponse, error)
 	ReadFile(*ReadFileRequest, JamsyncAPI_ReadFileServer) error
 	ChangeStream(*ChangeStreamRequest, JamsyncAPI_ChangeStreamServer) error
+	ExportSnapshot(*ExportSnapshotRequest, JamsyncAPI_ExportSnapshotServer) error
 	AddProject(context.Context, *AddProjectRequest) (*AddProjectResponse, error)
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	ListUserProjects(context.Context, *ListUserProjectsRequest) (*ListUserProjectsResponse, error)
+	RenameProject(context.Context, *RenameProjectRequest) (*RenameProjectResponse, error)
+	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	CreateBranch(context.Context, *CreateBranchRequest) (*BranchInfo, error)
+	ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error)
+	MergeBranch(context.Context, *MergeBranchRequest) (*MergeBranchResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*TagInfo, error)
+	GetTag(context.Context, *GetTagRequest) (*TagInfo, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
 	ListCommittedChanges(context.Context, *ListCommittedChangesRequest) (*ListCommittedChangesResponse, error)
+	GetChange(context.Context, *GetChangeRequest) (*ChangeInfo, error)
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	RestoreFile(context.Context, *RestoreFileRequest) (*RestoreFileResponse, error)
+	RevertChange(context.Context, *RevertChangeRequest) (*RevertChangeResponse, error)
+	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
+	CopyFile(context.Context, *CopyFileRequest) (*CopyFileResponse, error)
 	GetProjectConfig(context.Context, *GetProjectConfigRequest) (*ProjectConfig, error)
+	AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error)
+	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
+	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
+	SetProjectIgnorePatterns(context.Context, *SetProjectIgnorePatternsRequest) (*SetProjectIgnorePatternsResponse, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
@@ -274,9 +550,15 @@ func (UnimplementedJamsyncAPIServer) CreateChange(context.Context, *CreateChange
 func (UnimplementedJamsyncAPIServer) WriteOperationStream(JamsyncAPI_WriteOperationStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method WriteOperationStream not implemented")
 }
+func (UnimplementedJamsyncAPIServer) GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadStatus not implemented")
+}
 func (UnimplementedJamsyncAPIServer) CommitChange(context.Context, *CommitChangeRequest) (*CommitChangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitChange not implemented")
 }
+func (UnimplementedJamsyncAPIServer) AbortChange(context.Context, *AbortChangeRequest) (*AbortChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortChange not implemented")
+}
 func (UnimplementedJamsyncAPIServer) ReadBlockHashes(context.Context, *ReadBlockHashesRequest) (*ReadBlockHashesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadBlockHashes not implemented")
 }
@@ -286,6 +568,9 @@ func (UnimplementedJamsyncAPIServer) ReadFile(*ReadFileRequest, JamsyncAPI_ReadF
 func (UnimplementedJamsyncAPIServer) ChangeStream(*ChangeStreamRequest, JamsyncAPI_ChangeStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ChangeStream not implemented")
 }
+func (UnimplementedJamsyncAPIServer) ExportSnapshot(*ExportSnapshotRequest, JamsyncAPI_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
 func (UnimplementedJamsyncAPIServer) AddProject(context.Context, *AddProjectRequest) (*AddProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddProject not implemented")
 }
@@ -295,12 +580,72 @@ func (UnimplementedJamsyncAPIServer) ListProjects(context.Context, *ListProjects
 func (UnimplementedJamsyncAPIServer) ListUserProjects(context.Context, *ListUserProjectsRequest) (*ListUserProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserProjects not implemented")
 }
+func (UnimplementedJamsyncAPIServer) RenameProject(context.Context, *RenameProjectRequest) (*RenameProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameProject not implemented")
+}
+func (UnimplementedJamsyncAPIServer) ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveProject not implemented")
+}
+func (UnimplementedJamsyncAPIServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedJamsyncAPIServer) CreateBranch(context.Context, *CreateBranchRequest) (*BranchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedJamsyncAPIServer) ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBranches not implemented")
+}
+func (UnimplementedJamsyncAPIServer) MergeBranch(context.Context, *MergeBranchRequest) (*MergeBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBranch not implemented")
+}
+func (UnimplementedJamsyncAPIServer) CreateTag(context.Context, *CreateTagRequest) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedJamsyncAPIServer) GetTag(context.Context, *GetTagRequest) (*TagInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedJamsyncAPIServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedJamsyncAPIServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
 func (UnimplementedJamsyncAPIServer) ListCommittedChanges(context.Context, *ListCommittedChangesRequest) (*ListCommittedChangesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCommittedChanges not implemented")
 }
+func (UnimplementedJamsyncAPIServer) GetChange(context.Context, *GetChangeRequest) (*ChangeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChange not implemented")
+}
+func (UnimplementedJamsyncAPIServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedJamsyncAPIServer) RestoreFile(context.Context, *RestoreFileRequest) (*RestoreFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFile not implemented")
+}
+func (UnimplementedJamsyncAPIServer) RevertChange(context.Context, *RevertChangeRequest) (*RevertChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertChange not implemented")
+}
+func (UnimplementedJamsyncAPIServer) DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffFile not implemented")
+}
+func (UnimplementedJamsyncAPIServer) CopyFile(context.Context, *CopyFileRequest) (*CopyFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFile not implemented")
+}
 func (UnimplementedJamsyncAPIServer) GetProjectConfig(context.Context, *GetProjectConfigRequest) (*ProjectConfig, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProjectConfig not implemented")
 }
+func (UnimplementedJamsyncAPIServer) AddProjectMember(context.Context, *AddProjectMemberRequest) (*AddProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProjectMember not implemented")
+}
+func (UnimplementedJamsyncAPIServer) RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProjectMember not implemented")
+}
+func (UnimplementedJamsyncAPIServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
+}
+func (UnimplementedJamsyncAPIServer) SetProjectIgnorePatterns(context.Context, *SetProjectIgnorePatternsRequest) (*SetProjectIgnorePatternsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProjectIgnorePatterns not implemented")
+}
 func (UnimplementedJamsyncAPIServer) UserInfo(context.Context, *UserInfoRequest) (*UserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserInfo not implemented")
 }
@@ -367,6 +712,24 @@ func (x *jamsyncAPIWriteOperationStreamServer) Recv() (*Operation, error) {
 	return m, nil
 }
 
+func _JamsyncAPI_GetUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).GetUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/GetUploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).GetUploadStatus(ctx, req.(*GetUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JamsyncAPI_CommitChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CommitChangeRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +748,24 @@ func _JamsyncAPI_CommitChange_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JamsyncAPI_AbortChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).AbortChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/AbortChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).AbortChange(ctx, req.(*AbortChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JamsyncAPI_ReadBlockHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadBlockHashesRequest)
 	if err := dec(in); err != nil {
@@ -445,6 +826,27 @@ func (x *jamsyncAPIChangeStreamServer) Send(m *ChangeStreamMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _JamsyncAPI_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JamsyncAPIServer).ExportSnapshot(m, &jamsyncAPIExportSnapshotServer{stream})
+}
+
+type JamsyncAPI_ExportSnapshotServer interface {
+	Send(*SnapshotChunk) error
+	grpc.ServerStream
+}
+
+type jamsyncAPIExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *jamsyncAPIExportSnapshotServer) Send(m *SnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _JamsyncAPI_AddProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddProjectRequest)
 	if err := dec(in); err != nil {
@@ -499,111 +901,479 @@ func _JamsyncAPI_ListUserProjects_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JamsyncAPI_ListCommittedChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCommittedChangesRequest)
+func _JamsyncAPI_RenameProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JamsyncAPIServer).ListCommittedChanges(ctx, in)
+		return srv.(JamsyncAPIServer).RenameProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.JamsyncAPI/ListCommittedChanges",
+		FullMethod: "/pb.JamsyncAPI/RenameProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JamsyncAPIServer).ListCommittedChanges(ctx, req.(*ListCommittedChangesRequest))
+		return srv.(JamsyncAPIServer).RenameProject(ctx, req.(*RenameProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JamsyncAPI_GetProjectConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectConfigRequest)
+func _JamsyncAPI_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JamsyncAPIServer).GetProjectConfig(ctx, in)
+		return srv.(JamsyncAPIServer).ArchiveProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.JamsyncAPI/GetProjectConfig",
+		FullMethod: "/pb.JamsyncAPI/ArchiveProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JamsyncAPIServer).GetProjectConfig(ctx, req.(*GetProjectConfigRequest))
+		return srv.(JamsyncAPIServer).ArchiveProject(ctx, req.(*ArchiveProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JamsyncAPI_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserInfoRequest)
+func _JamsyncAPI_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JamsyncAPIServer).UserInfo(ctx, in)
+		return srv.(JamsyncAPIServer).DeleteProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.JamsyncAPI/UserInfo",
+		FullMethod: "/pb.JamsyncAPI/DeleteProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JamsyncAPIServer).UserInfo(ctx, req.(*UserInfoRequest))
+		return srv.(JamsyncAPIServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JamsyncAPI_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _JamsyncAPI_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JamsyncAPIServer).CreateUser(ctx, in)
+		return srv.(JamsyncAPIServer).CreateBranch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.JamsyncAPI/CreateUser",
+		FullMethod: "/pb.JamsyncAPI/CreateBranch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JamsyncAPIServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(JamsyncAPIServer).CreateBranch(ctx, req.(*CreateBranchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _JamsyncAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _JamsyncAPI_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(JamsyncAPIServer).Ping(ctx, in)
+		return srv.(JamsyncAPIServer).ListBranches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.JamsyncAPI/Ping",
+		FullMethod: "/pb.JamsyncAPI/ListBranches",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(JamsyncAPIServer).Ping(ctx, req.(*PingRequest))
+		return srv.(JamsyncAPIServer).ListBranches(ctx, req.(*ListBranchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// JamsyncAPI_ServiceDesc is the grpc.ServiceDesc for JamsyncAPI service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var JamsyncAPI_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.JamsyncAPI",
-	HandlerType: (*JamsyncAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateChange",
-			Handler:    _JamsyncAPI_CreateChange_Handler,
-		},
-		{
+func _JamsyncAPI_MergeBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).MergeBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/MergeBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).MergeBranch(ctx, req.(*MergeBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/CreateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/GetTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_ListCommittedChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommittedChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).ListCommittedChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/ListCommittedChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).ListCommittedChanges(ctx, req.(*ListCommittedChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_GetChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).GetChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/GetChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).GetChange(ctx, req.(*GetChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/ListChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_RestoreFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).RestoreFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/RestoreFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).RestoreFile(ctx, req.(*RestoreFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_RevertChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).RevertChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/RevertChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).RevertChange(ctx, req.(*RevertChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_DiffFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).DiffFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/DiffFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).DiffFile(ctx, req.(*DiffFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_CopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).CopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/CopyFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).CopyFile(ctx, req.(*CopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_GetProjectConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).GetProjectConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/GetProjectConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).GetProjectConfig(ctx, req.(*GetProjectConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_AddProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).AddProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/AddProjectMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).AddProjectMember(ctx, req.(*AddProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_RemoveProjectMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProjectMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).RemoveProjectMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/RemoveProjectMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).RemoveProjectMember(ctx, req.(*RemoveProjectMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).ListProjectMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/ListProjectMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).ListProjectMembers(ctx, req.(*ListProjectMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_SetProjectIgnorePatterns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProjectIgnorePatternsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).SetProjectIgnorePatterns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/SetProjectIgnorePatterns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).SetProjectIgnorePatterns(ctx, req.(*SetProjectIgnorePatternsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_UserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).UserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/UserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).UserInfo(ctx, req.(*UserInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JamsyncAPI_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JamsyncAPIServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.JamsyncAPI/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JamsyncAPIServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JamsyncAPI_ServiceDesc is the grpc.ServiceDesc for JamsyncAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JamsyncAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.JamsyncAPI",
+	HandlerType: (*JamsyncAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChange",
+			Handler:    _JamsyncAPI_CreateChange_Handler,
+		},
+		{
+			MethodName: "GetUploadStatus",
+			Handler:    _JamsyncAPI_GetUploadStatus_Handler,
+		},
+		{
 			MethodName: "CommitChange",
 			Handler:    _JamsyncAPI_CommitChange_Handler,
 		},
+		{
+			MethodName: "AbortChange",
+			Handler:    _JamsyncAPI_AbortChange_Handler,
+		},
 		{
 			MethodName: "ReadBlockHashes",
 			Handler:    _JamsyncAPI_ReadBlockHashes_Handler,
@@ -620,14 +1390,94 @@ var JamsyncAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUserProjects",
 			Handler:    _JamsyncAPI_ListUserProjects_Handler,
 		},
+		{
+			MethodName: "RenameProject",
+			Handler:    _JamsyncAPI_RenameProject_Handler,
+		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _JamsyncAPI_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _JamsyncAPI_DeleteProject_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _JamsyncAPI_CreateBranch_Handler,
+		},
+		{
+			MethodName: "ListBranches",
+			Handler:    _JamsyncAPI_ListBranches_Handler,
+		},
+		{
+			MethodName: "MergeBranch",
+			Handler:    _JamsyncAPI_MergeBranch_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _JamsyncAPI_CreateTag_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _JamsyncAPI_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _JamsyncAPI_ListTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _JamsyncAPI_DeleteTag_Handler,
+		},
 		{
 			MethodName: "ListCommittedChanges",
 			Handler:    _JamsyncAPI_ListCommittedChanges_Handler,
 		},
+		{
+			MethodName: "GetChange",
+			Handler:    _JamsyncAPI_GetChange_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _JamsyncAPI_ListChanges_Handler,
+		},
+		{
+			MethodName: "RestoreFile",
+			Handler:    _JamsyncAPI_RestoreFile_Handler,
+		},
+		{
+			MethodName: "RevertChange",
+			Handler:    _JamsyncAPI_RevertChange_Handler,
+		},
+		{
+			MethodName: "DiffFile",
+			Handler:    _JamsyncAPI_DiffFile_Handler,
+		},
+		{
+			MethodName: "CopyFile",
+			Handler:    _JamsyncAPI_CopyFile_Handler,
+		},
 		{
 			MethodName: "GetProjectConfig",
 			Handler:    _JamsyncAPI_GetProjectConfig_Handler,
 		},
+		{
+			MethodName: "AddProjectMember",
+			Handler:    _JamsyncAPI_AddProjectMember_Handler,
+		},
+		{
+			MethodName: "RemoveProjectMember",
+			Handler:    _JamsyncAPI_RemoveProjectMember_Handler,
+		},
+		{
+			MethodName: "ListProjectMembers",
+			Handler:    _JamsyncAPI_ListProjectMembers_Handler,
+		},
+		{
+			MethodName: "SetProjectIgnorePatterns",
+			Handler:    _JamsyncAPI_SetProjectIgnorePatterns_Handler,
+		},
 		{
 			MethodName: "UserInfo",
 			Handler:    _JamsyncAPI_UserInfo_Handler,
@@ -657,6 +1507,11 @@ var JamsyncAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JamsyncAPI_ChangeStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _JamsyncAPI_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pb.proto",
 }
@@ -0,0 +1,139 @@
+// Package diff implements line based diffing and three-way merging of text files.
+package diff
+
+import "bytes"
+
+// Hunk is a region of lines [AStart, AEnd) in a that was replaced by the lines
+// [BStart, BEnd) in b. Either region may be empty.
+type Hunk struct {
+	AStart, AEnd int
+	BStart, BEnd int
+}
+
+// SplitLines splits data into lines, keeping the line terminators so that joining
+// the lines reproduces data exactly.
+func SplitLines(data []byte) []string {
+	lines := make([]string, 0, bytes.Count(data, []byte{'\n'})+1)
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			lines = append(lines, string(data))
+			break
+		}
+		lines = append(lines, string(data[:i+1]))
+		data = data[i+1:]
+	}
+	return lines
+}
+
+// IsBinary reports whether data looks like a binary file, using the same heuristic as
+// git of looking for a NUL byte near the start of the file.
+func IsBinary(data []byte) bool {
+	if len(data) > 8000 {
+		data = data[:8000]
+	}
+	return bytes.IndexByte(data, 0) >= 0
+}
+
+// Diff returns the hunks needed to turn a into b, ordered by position.
+func Diff(a, b []string) []Hunk {
+	// Common prefixes and suffixes are cheap to strip and keep the Myers search small.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	innerA := a[prefix : len(a)-suffix]
+	innerB := b[prefix : len(b)-suffix]
+
+	hunks := make([]Hunk, 0)
+	ia, ib := 0, 0
+	for _, match := range matches(innerA, innerB) {
+		if match[0] > ia || match[1] > ib {
+			hunks = append(hunks, Hunk{prefix + ia, prefix + match[0], prefix + ib, prefix + match[1]})
+		}
+		ia, ib = match[0]+1, match[1]+1
+	}
+	if ia < len(innerA) || ib < len(innerB) {
+		hunks = append(hunks, Hunk{prefix + ia, prefix + len(innerA), prefix + ib, prefix + len(innerB)})
+	}
+	return hunks
+}
+
+// matches returns the index pairs of lines that are kept between a and b using
+// Myers' O(ND) algorithm.
+func matches(a, b []string) [][2]int {
+	n, m := len(a), len(b)
+	max := n + m
+	if max == 0 {
+		return nil
+	}
+
+	// v[offset+k] holds the furthest x reached on diagonal k. Only the diagonals
+	// that were reachable at each step are kept in the trace to bound memory.
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	trace := make([][]int, 0)
+	for d := 0; d <= max; d++ {
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+
+		done := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+		if done {
+			break
+		}
+	}
+
+	pairs := make([][2]int, 0)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		var prevX, prevY int
+		if d > 0 {
+			prev := trace[d]
+			at := func(k int) int { return prev[k+d] }
+			k := x - y
+			var prevK int
+			if k == -d || (k != d && at(k-1) < at(k+1)) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+			prevX = at(prevK)
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			x--
+			y--
+			pairs = append(pairs, [2]int{x, y})
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(pairs)-1; i < j; i, j = i+1, j-1 {
+		pairs[i], pairs[j] = pairs[j], pairs[i]
+	}
+	return pairs
+}
@@ -0,0 +1,193 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want []Hunk
+	}{
+		{
+			name: "Equal",
+			a:    "a\nb\nc\n",
+			b:    "a\nb\nc\n",
+			want: []Hunk{},
+		},
+		{
+			name: "Insert",
+			a:    "a\nc\n",
+			b:    "a\nb\nc\n",
+			want: []Hunk{{1, 1, 1, 2}},
+		},
+		{
+			name: "Delete",
+			a:    "a\nb\nc\n",
+			b:    "a\nc\n",
+			want: []Hunk{{1, 2, 1, 1}},
+		},
+		{
+			name: "Replace",
+			a:    "a\nb\nc\nd\ne\n",
+			b:    "a\nx\nc\nd\ny\n",
+			want: []Hunk{{1, 2, 1, 2}, {4, 5, 4, 5}},
+		},
+		{
+			name: "From empty",
+			a:    "",
+			b:    "a\nb\n",
+			want: []Hunk{{0, 0, 0, 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Diff(SplitLines([]byte(tt.a)), SplitLines([]byte(tt.b))))
+		})
+	}
+}
+
+func TestDiffReconstructs(t *testing.T) {
+	a := SplitLines([]byte("the\nquick\nbrown\nfox\njumps\nover\nthe\nlazy\ndog"))
+	b := SplitLines([]byte("a\nquick\nred\nfox\njumps\nthe\nlazy\ndog\n!"))
+
+	var out strings.Builder
+	pos := 0
+	for _, h := range Diff(a, b) {
+		writeLines(&out, a[pos:h.AStart])
+		writeLines(&out, b[h.BStart:h.BEnd])
+		pos = h.AEnd
+	}
+	writeLines(&out, a[pos:])
+	require.Equal(t, strings.Join(b, ""), out.String())
+}
+
+func TestMerge3(t *testing.T) {
+	tests := []struct {
+		name          string
+		base          string
+		local         string
+		remote        string
+		want          string
+		wantConflicts int
+	}{
+		{
+			name:   "Only local changed",
+			base:   "a\nb\nc\n",
+			local:  "a\nB\nc\n",
+			remote: "a\nb\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "Only remote changed",
+			base:   "a\nb\nc\n",
+			local:  "a\nb\nc\n",
+			remote: "a\nb\nC\n",
+			want:   "a\nb\nC\n",
+		},
+		{
+			name:   "Separate edits",
+			base:   "1\n2\n3\n4\n5\n6\n7\n",
+			local:  "one\n2\n3\n4\n5\n6\n7\n",
+			remote: "1\n2\n3\n4\n5\n6\nseven\n",
+			want:   "one\n2\n3\n4\n5\n6\nseven\n",
+		},
+		{
+			name:   "Same edit on both sides",
+			base:   "a\nb\nc\n",
+			local:  "a\nx\nc\n",
+			remote: "a\nx\nc\n",
+			want:   "a\nx\nc\n",
+		},
+		{
+			name:          "Overlapping edits",
+			base:          "a\nb\nc\n",
+			local:         "a\nlocal\nc\n",
+			remote:        "a\nremote\nc\n",
+			want:          "a\n<<<<<<< local\nlocal\n=======\nremote\n>>>>>>> remote\nc\n",
+			wantConflicts: 1,
+		},
+		{
+			name:          "Both created",
+			base:          "",
+			local:         "local",
+			remote:        "remote",
+			want:          "<<<<<<< local\nlocal\n=======\nremote\n>>>>>>> remote\n",
+			wantConflicts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge3([]byte(tt.base), []byte(tt.local), []byte(tt.remote))
+			require.Equal(t, tt.want, string(merged))
+			require.Equal(t, tt.wantConflicts, conflicts)
+		})
+	}
+}
+
+func TestHasConflicts(t *testing.T) {
+	merged, conflicts := Merge3([]byte("a\nb\nc\n"), []byte("a\nlocal\nc\n"), []byte("a\nremote\nc\n"))
+	require.Equal(t, 1, conflicts)
+	require.True(t, HasConflicts(merged))
+	require.True(t, HasConflicts([]byte("<<<<<<< local\nx\n=======\ny\n>>>>>>> remote")))
+	require.False(t, HasConflicts([]byte("a\nlocal\nc\n")))
+	// Markers out of order or missing one are not a conflict.
+	require.False(t, HasConflicts([]byte(">>>>>>> remote\n=======\n<<<<<<< local\n")))
+	require.False(t, HasConflicts([]byte("<<<<<<< local\nx\n>>>>>>> remote\n")))
+}
+
+func TestIsBinary(t *testing.T) {
+	require.False(t, IsBinary([]byte("just some text\n")))
+	require.True(t, IsBinary([]byte{'a', 0, 'b'}))
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			name: "Equal",
+			a:    "a\nb\n",
+			b:    "a\nb\n",
+			want: "",
+		},
+		{
+			name: "Change",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:    "1\n2\n3\n4\nfive\n6\n7\n8\n9\n",
+			want: "--- a\n+++ b\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			name: "SeparateHunks",
+			a:    "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			b:    "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n",
+			want: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n",
+		},
+		{
+			name: "FromEmpty",
+			a:    "",
+			b:    "a\n",
+			want: "--- a\n+++ b\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name: "NoNewlineAtEnd",
+			a:    "a\n",
+			b:    "a\nb",
+			want: "--- a\n+++ b\n@@ -1 +1,2 @@\n a\n+b\n\\ No newline at end of file\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Unified("a", "b", SplitLines([]byte(tt.a)), SplitLines([]byte(tt.b)), 3)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package diff
+
+import "strings"
+
+const (
+	conflictStart  = "<<<<<<< local\n"
+	conflictMiddle = "=======\n"
+	conflictEnd    = ">>>>>>> remote\n"
+)
+
+// Merge3 merges the edits made in local and remote since base. Edits to separate
+// regions of base are combined, and overlapping edits that are not identical are
+// written between conflict markers. The number of conflicting regions is returned.
+func Merge3(base, local, remote []byte) (merged []byte, conflicts int) {
+	baseLines := SplitLines(base)
+	localLines := SplitLines(local)
+	remoteLines := SplitLines(remote)
+	localHunks := Diff(baseLines, localLines)
+	remoteHunks := Diff(baseLines, remoteLines)
+
+	var out strings.Builder
+	pos := 0
+	i, j := 0, 0
+	for i < len(localHunks) || j < len(remoteHunks) {
+		// Start a region at the earliest remaining hunk and grow it while hunks from
+		// either side overlap or touch it.
+		var start, end int
+		if j >= len(remoteHunks) || (i < len(localHunks) && localHunks[i].AStart <= remoteHunks[j].AStart) {
+			start, end = localHunks[i].AStart, localHunks[i].AEnd
+		} else {
+			start, end = remoteHunks[j].AStart, remoteHunks[j].AEnd
+		}
+		localStart, remoteStart := i, j
+		for {
+			if i < len(localHunks) && localHunks[i].AStart <= end {
+				end = maxInt(end, localHunks[i].AEnd)
+				i++
+			} else if j < len(remoteHunks) && remoteHunks[j].AStart <= end {
+				end = maxInt(end, remoteHunks[j].AEnd)
+				j++
+			} else {
+				break
+			}
+		}
+
+		writeLines(&out, baseLines[pos:start])
+		localRegion := applyHunks(baseLines, localLines, localHunks[localStart:i], start, end)
+		remoteRegion := applyHunks(baseLines, remoteLines, remoteHunks[remoteStart:j], start, end)
+		switch {
+		case localStart == i:
+			out.WriteString(remoteRegion)
+		case remoteStart == j, localRegion == remoteRegion:
+			out.WriteString(localRegion)
+		default:
+			conflicts++
+			out.WriteString(conflictStart)
+			writeTerminated(&out, localRegion)
+			out.WriteString(conflictMiddle)
+			writeTerminated(&out, remoteRegion)
+			out.WriteString(conflictEnd)
+		}
+		pos = end
+	}
+	writeLines(&out, baseLines[pos:])
+
+	return []byte(out.String()), conflicts
+}
+
+// HasConflicts reports whether data still has a conflict written by Merge3, with its
+// start, middle and end markers in order.
+func HasConflicts(data []byte) bool {
+	marker := 0
+	markers := []string{conflictStart, conflictMiddle, conflictEnd}
+	for _, line := range SplitLines(data) {
+		// The last line of a file may have lost its terminator while being edited.
+		line = strings.TrimSuffix(line, "\n") + "\n"
+		if line == markers[marker] {
+			marker++
+			if marker == len(markers) {
+				return true
+			}
+		} else if line == conflictStart {
+			marker = 1
+		}
+	}
+	return false
+}
+
+// applyHunks returns the lines of base in [start, end) with hunks applied, taking the
+// replacement lines from side.
+func applyHunks(base []string, side []string, hunks []Hunk, start int, end int) string {
+	var out strings.Builder
+	curr := start
+	for _, h := range hunks {
+		writeLines(&out, base[curr:h.AStart])
+		writeLines(&out, side[h.BStart:h.BEnd])
+		curr = h.AEnd
+	}
+	writeLines(&out, base[curr:end])
+	return out.String()
+}
+
+func writeLines(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// writeTerminated writes s and makes sure that a conflict marker written after it
+// starts on its own line.
+func writeTerminated(out *strings.Builder, s string) {
+	out.WriteString(s)
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		out.WriteString("\n")
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,72 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Unified returns a unified diff that turns a into b, with up to context unchanged
+// lines around each change. It is empty when a and b are equal.
+func Unified(aName, bName string, a, b []string, context int) string {
+	hunks := Diff(a, b)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+	for len(hunks) > 0 {
+		// Changes closer together than twice the context share a single hunk.
+		group := 1
+		for group < len(hunks) && hunks[group].AStart-hunks[group-1].AEnd <= 2*context {
+			group++
+		}
+
+		first, last := hunks[0], hunks[group-1]
+		aStart := maxInt(first.AStart-context, 0)
+		aEnd := minInt(last.AEnd+context, len(a))
+		bStart := first.BStart - (first.AStart - aStart)
+		bEnd := last.BEnd + (aEnd - last.AEnd)
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(aStart, aEnd), hunkRange(bStart, bEnd))
+
+		i := aStart
+		for _, h := range hunks[:group] {
+			writeUnifiedLines(&out, " ", a[i:h.AStart])
+			writeUnifiedLines(&out, "-", a[h.AStart:h.AEnd])
+			writeUnifiedLines(&out, "+", b[h.BStart:h.BEnd])
+			i = h.AEnd
+		}
+		writeUnifiedLines(&out, " ", a[i:aEnd])
+		hunks = hunks[group:]
+	}
+	return out.String()
+}
+
+// hunkRange formats the lines [start, end) as a unified diff range, in which an empty
+// range is numbered by the line before it.
+func hunkRange(start, end int) string {
+	if end-start == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if end-start == 1 {
+		return fmt.Sprint(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
+
+func writeUnifiedLines(out *strings.Builder, prefix string, lines []string) {
+	for _, line := range lines {
+		out.WriteString(prefix)
+		out.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
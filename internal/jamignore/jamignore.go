@@ -0,0 +1,218 @@
+// Package jamignore decides which paths of a project are ignored using gitignore
+// compatible .jamignore files.
+package jamignore
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// FileName is the name of the files that ignore patterns are read from. Patterns in
+// a .jamignore file apply to the directory that contains it and everything below.
+const FileName = ".jamignore"
+
+// DefaultProjectPatterns are the ignore patterns a project uses until its owner
+// configures a different list.
+var DefaultProjectPatterns = []string{".git/", ".next/", "node_modules/", "jb/"}
+
+type pattern struct {
+	base     string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+	re       *regexp.Regexp
+}
+
+// Matcher matches slash separated paths relative to a root directory. Nested
+// .jamignore files are read lazily the first time a path below them is matched.
+type Matcher struct {
+	root     string
+	defaults []pattern
+
+	mu   sync.Mutex
+	dirs map[string][]pattern
+}
+
+// New returns a Matcher for the directory root. The default patterns are applied
+// before any .jamignore file, so .jamignore files can negate them.
+func New(root string, defaults []string) *Matcher {
+	return &Matcher{
+		root:     root,
+		defaults: parse("", defaults),
+		dirs:     make(map[string][]pattern),
+	}
+}
+
+// Match reports whether path is ignored. A path is also ignored when any directory
+// above it is ignored.
+func (m *Matcher) Match(filePath string, isDir bool) bool {
+	filePath = path.Clean(filepath.ToSlash(filePath))
+	if filePath == "." || filePath == "" {
+		return false
+	}
+
+	parts := strings.Split(filePath, "/")
+	for i := 1; i < len(parts); i++ {
+		if m.matchPath(parts[:i], true) {
+			return true
+		}
+	}
+	return m.matchPath(parts, isDir)
+}
+
+// Reload forgets every .jamignore file read so far so that changes to them are
+// picked up by the next Match.
+func (m *Matcher) Reload() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dirs = make(map[string][]pattern)
+}
+
+// matchPath applies every pattern that can affect the path made of parts, from the
+// defaults down to the closest .jamignore, with later patterns taking precedence.
+func (m *Matcher) matchPath(parts []string, isDir bool) bool {
+	filePath := strings.Join(parts, "/")
+	ignored := false
+	apply := func(patterns []pattern) {
+		for _, p := range patterns {
+			if p.dirOnly && !isDir {
+				continue
+			}
+			if p.matches(filePath) {
+				ignored = !p.negate
+			}
+		}
+	}
+
+	apply(m.defaults)
+	for i := 0; i < len(parts); i++ {
+		apply(m.dirPatterns(strings.Join(parts[:i], "/")))
+	}
+	return ignored
+}
+
+func (m *Matcher) dirPatterns(dir string) []pattern {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if patterns, ok := m.dirs[dir]; ok {
+		return patterns
+	}
+	data, err := os.ReadFile(filepath.Join(m.root, filepath.FromSlash(dir), FileName))
+	var patterns []pattern
+	if err == nil {
+		patterns = parse(dir, readLines(data))
+	}
+	m.dirs[dir] = patterns
+	return patterns
+}
+
+func readLines(data []byte) []string {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines
+}
+
+func parse(base string, lines []string) []pattern {
+	patterns := make([]pattern, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		if !strings.HasSuffix(line, "\\ ") {
+			line = strings.TrimRight(line, " ")
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		p := pattern{base: base}
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, "\\!") || strings.HasPrefix(line, "\\#") {
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		// Patterns containing a slash are relative to the .jamignore directory, the
+		// rest match a file name at any depth.
+		if strings.Contains(line, "/") {
+			p.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+
+		re, err := regexp.Compile("^" + globToRegexp(line) + "$")
+		if err != nil {
+			continue
+		}
+		p.re = re
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
+func (p pattern) matches(filePath string) bool {
+	if p.base != "" {
+		if !strings.HasPrefix(filePath, p.base+"/") {
+			return false
+		}
+		filePath = strings.TrimPrefix(filePath, p.base+"/")
+	}
+	if !p.anchored {
+		filePath = path.Base(filePath)
+	}
+	return p.re.MatchString(filePath)
+}
+
+func globToRegexp(glob string) string {
+	var re strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/") && (i == 0 || glob[i-1] == '/'):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**") && i+3 == len(glob):
+			re.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case c == '*':
+			re.WriteString("[^/]*")
+		case c == '?':
+			re.WriteString("[^/]")
+		case c == '\\' && i+1 < len(glob):
+			i++
+			re.WriteString(regexp.QuoteMeta(string(glob[i])))
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				re.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re.WriteString("[" + strings.ReplaceAll(class, "\\", "\\\\") + "]")
+			i += end + 1
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return re.String()
+}
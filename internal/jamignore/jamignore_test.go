@@ -0,0 +1,82 @@
+package jamignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	root := t.TempDir()
+	writeFile := func(path string, data string) {
+		fullPath := filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), os.ModePerm))
+		require.NoError(t, os.WriteFile(fullPath, []byte(data), 0644))
+	}
+	writeFile(".jamignore", `# build outputs
+*.log
+!important.log
+build/
+/venv
+docs/**/*.pdf
+a?c.txt
+[bc]at.txt
+`)
+	writeFile("nested/.jamignore", `tmp
+!keep.log
+/local.txt
+`)
+
+	m := New(root, DefaultProjectPatterns)
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{path: "main.go", want: false},
+		{path: ".git", isDir: true, want: true},
+		{path: ".git/HEAD", want: true},
+		{path: ".gitignore", want: false},
+		{path: "node_modules/pkg/index.js", want: true},
+		{path: "src/node_modules", isDir: true, want: true},
+		{path: "debug.log", want: true},
+		{path: "src/debug.log", want: true},
+		{path: "important.log", want: false},
+		{path: "build", isDir: true, want: true},
+		{path: "build", isDir: false, want: false},
+		{path: "src/build/out.o", want: true},
+		{path: "venv", isDir: true, want: true},
+		{path: "venv/bin/python", want: true},
+		{path: "src/venv", isDir: true, want: false},
+		{path: "docs/a.pdf", want: true},
+		{path: "docs/x/y/a.pdf", want: true},
+		{path: "a.pdf", want: false},
+		{path: "abc.txt", want: true},
+		{path: "abbc.txt", want: false},
+		{path: "cat.txt", want: true},
+		{path: "hat.txt", want: false},
+		{path: "nested/tmp", want: true},
+		{path: "nested/deeper/tmp", isDir: true, want: true},
+		{path: "tmp", want: false},
+		{path: "nested/keep.log", want: false},
+		{path: "nested/other.log", want: true},
+		{path: "nested/local.txt", want: true},
+		{path: "nested/deeper/local.txt", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.want, m.Match(tt.path, tt.isDir))
+		})
+	}
+}
+
+func TestMatchNegateDefault(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, FileName), []byte("!jb/\n"), 0644))
+
+	m := New(root, DefaultProjectPatterns)
+	require.False(t, m.Match("jb", true))
+	require.True(t, m.Match("node_modules", true))
+}
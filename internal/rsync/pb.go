@@ -35,3 +35,26 @@ func PbOperationToRsync(op *pb.Operation) Operation {
 		Data:          op.GetData(),
 	}
 }
+
+// RsyncOperationToPb converts an operation to its protobuf form. The caller sets the
+// project, change and path that the operation belongs to.
+func RsyncOperationToPb(op Operation) *pb.Operation {
+	var opType pb.Operation_Type
+	switch op.Type {
+	case OpBlock:
+		opType = pb.Operation_OpBlock
+	case OpData:
+		opType = pb.Operation_OpData
+	case OpHash:
+		opType = pb.Operation_OpHash
+	case OpBlockRange:
+		opType = pb.Operation_OpBlockRange
+	}
+
+	return &pb.Operation{
+		Type:          opType,
+		BlockIndex:    op.BlockIndex,
+		BlockIndexEnd: op.BlockIndexEnd,
+		Data:          op.Data,
+	}
+}
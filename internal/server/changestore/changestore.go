@@ -0,0 +1,393 @@
+package changestore
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Change is the metadata recorded for a change.
+type Change struct {
+	Id              uint64
+	AuthorId        string
+	Message         string
+	Hostname        string
+	BaseChangeId    *uint64
+	Branch          string
+	Timestamp       time.Time
+	Committed       bool
+	CommitTimestamp time.Time
+	Aborted         bool
+	Files           []ChangeFile
+}
+
+// ChangeState is whether a change was committed or aborted. A change that is
+// neither is still open.
+type ChangeState struct {
+	Id        uint64
+	Committed bool
+	Aborted   bool
+}
+
+// Commit is when a change was committed, and to which branch.
+type Commit struct {
+	ChangeId  uint64
+	Timestamp time.Time
+	Branch    string
+}
+
+// MainBranch is the branch that every project starts out with.
+const MainBranch = "main"
+
+// Branch is a named line of changes forked from the head of its parent branch at
+// ForkChangeId. Its changes build on the changes of the parent up to the fork rather
+// than on everything before them. MergeBaseChangeId is the change that the branch was
+// last merged into its parent at, or the fork if it was never merged.
+type Branch struct {
+	Name              string
+	Parent            string
+	ForkChangeId      uint64
+	MergeBaseChangeId uint64
+	HeadChangeId      uint64
+	Timestamp         time.Time
+}
+
+// Tag names a committed change so that its state can be found again.
+type Tag struct {
+	Name      string
+	ChangeId  uint64
+	Message   string
+	AuthorId  string
+	Timestamp time.Time
+}
+
+// ChangeFile is a file written in a change. Path is empty until the client that
+// wrote the file sends it on commit.
+type ChangeFile struct {
+	PathHash uint64
+	Path     string
+}
+
+// ErrChangeNotOpen is returned when committing or aborting a change that was already
+// committed or aborted.
+var ErrChangeNotOpen = errors.New("change is not open")
+
+// ErrChangeNotCommitted is returned when tagging a change that is open or aborted.
+var ErrChangeNotCommitted = errors.New("change is not committed")
+
+var (
+	ErrBranchExists   = errors.New("branch already exists")
+	ErrBranchNotFound = errors.New("branch not found")
+	ErrTagExists      = errors.New("tag already exists")
+	ErrTagNotFound    = errors.New("tag not found")
+)
+
+// ConflictError is returned when committing a change whose base is behind changes
+// that were committed to the same paths since.
+type ConflictError struct {
+	BaseChangeId   uint64
+	LatestChangeId uint64
+	Paths          []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("change %d touched %s after base change %d", e.LatestChangeId, strings.Join(e.Paths, ", "), e.BaseChangeId)
+}
+
+// ChangeStore records the changes made to each project. A change starts out open and
+// is then either committed or aborted, once. Commits of changes with a base change
+// fail with a *ConflictError if they would overwrite changes made after the base.
+// Operations that need a change to exist return sql.ErrNoRows when it doesn't.
+//
+// Change ids are shared by every branch of a project, and a change builds on the
+// changes in its ancestry: those of its own branch before it, then those of the
+// parent branch up to the fork and so on. Operations that need a branch to exist
+// return ErrBranchNotFound when it doesn't.
+type ChangeStore interface {
+	AddChange(projectId uint64, ownerId string, branch string, authorId string, message string, hostname string, baseChangeId *uint64) (uint64, error)
+	GetCurrentChange(projectId uint64, ownerId string) (uint64, time.Time, error)
+	// GetLatestCommittedChange returns the head of the main branch.
+	GetLatestCommittedChange(projectId uint64, ownerId string) (uint64, error)
+	// ListChangeStates lists the changes after afterId in the ancestry of upToId.
+	ListChangeStates(projectId uint64, ownerId string, afterId uint64, upToId uint64) ([]ChangeState, error)
+	// GetMainChange returns the latest change of the main branch in the ancestry of
+	// changeId.
+	GetMainChange(projectId uint64, ownerId string, changeId uint64) (uint64, error)
+	CommitChange(projectId uint64, ownerId string, changeId uint64, message string, files []ChangeFile, baseChangeId *uint64) error
+	AbortChange(projectId uint64, ownerId string, changeId uint64) error
+	ListOpenChanges(projectId uint64, ownerId string) ([]Change, error)
+	AddChangeFiles(projectId uint64, ownerId string, changeId uint64, files []ChangeFile) error
+	GetChange(projectId uint64, ownerId string, changeId uint64) (Change, error)
+	ListChanges(projectId uint64, ownerId string, beforeId uint64, limit int) ([]Change, error)
+	ListCommittedChanges(projectId uint64, ownerId string) ([]uint64, error)
+	ListCommits(projectId uint64, ownerId string) ([]Commit, error)
+	ListPathChanges(projectId uint64, ownerId string) (map[uint64][]uint64, error)
+	// The baseline change is the oldest change whose content can still be read after
+	// the operations of the changes before it were compacted away. It is 0 until a
+	// project is first compacted.
+	GetBaselineChange(projectId uint64, ownerId string) (uint64, error)
+	SetBaselineChange(projectId uint64, ownerId string, changeId uint64) error
+	// CreateBranch forks a branch from the head of parent.
+	CreateBranch(projectId uint64, ownerId string, name string, parent string) (Branch, error)
+	GetBranch(projectId uint64, ownerId string, name string) (Branch, error)
+	ListBranches(projectId uint64, ownerId string) ([]Branch, error)
+	// GetBranchHead returns the latest change committed to a branch, or the change it
+	// was forked from until something is committed to it.
+	GetBranchHead(projectId uint64, ownerId string, branch string) (uint64, error)
+	SetBranchMergeBase(projectId uint64, ownerId string, name string, changeId uint64) error
+	CreateTag(projectId uint64, ownerId string, name string, changeId uint64, authorId string, message string) (Tag, error)
+	GetTag(projectId uint64, ownerId string, name string) (Tag, error)
+	// ListTags returns every tag in ascending order of change id.
+	ListTags(projectId uint64, ownerId string) ([]Tag, error)
+	DeleteTag(projectId uint64, ownerId string, name string) error
+	// DeleteProject closes the database of a project and removes it.
+	DeleteProject(projectId uint64, ownerId string) error
+}
+
+// sqlChangeStore implements ChangeStore with one sqlite database per project, opened
+// on first use. Changes are added, committed and aborted one at a time so that two
+// changes never get the same id and sqlite never has to upgrade two concurrent read
+// transactions to write ones.
+type sqlChangeStore struct {
+	open         func(projectId uint64, ownerId string) (*sql.DB, error)
+	remove       func(projectId uint64, ownerId string) error
+	mu           *sync.Mutex
+	transitionMu *sync.Mutex
+	dbs          map[string]*sql.DB
+}
+
+func newSqlChangeStore(open func(projectId uint64, ownerId string) (*sql.DB, error), remove func(projectId uint64, ownerId string) error) sqlChangeStore {
+	return sqlChangeStore{
+		open:         open,
+		remove:       remove,
+		mu:           &sync.Mutex{},
+		transitionMu: &sync.Mutex{},
+		dbs:          make(map[string]*sql.DB),
+	}
+}
+
+func (s sqlChangeStore) projectDB(projectId uint64, ownerId string) (*sql.DB, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%d", ownerId, projectId)
+	if db, ok := s.dbs[key]; ok {
+		return db, nil
+	}
+
+	db, err := s.open(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	err = setup(db)
+	if err != nil {
+		return nil, err
+	}
+
+	s.dbs[key] = db
+	return db, nil
+}
+
+func (s sqlChangeStore) DeleteProject(projectId uint64, ownerId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%d", ownerId, projectId)
+	if db, ok := s.dbs[key]; ok {
+		delete(s.dbs, key)
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return s.remove(projectId, ownerId)
+}
+
+func (s sqlChangeStore) AddChange(projectId uint64, ownerId string, branch string, authorId string, message string, hostname string, baseChangeId *uint64) (uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	s.transitionMu.Lock()
+	defer s.transitionMu.Unlock()
+	return addChange(db, branch, authorId, message, hostname, baseChangeId)
+}
+func (s sqlChangeStore) GetCurrentChange(projectId uint64, ownerId string) (uint64, time.Time, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return getCurrentChange(db)
+}
+func (s sqlChangeStore) GetLatestCommittedChange(projectId uint64, ownerId string) (uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	return getLatestCommittedChange(db)
+}
+func (s sqlChangeStore) ListChangeStates(projectId uint64, ownerId string, afterId uint64, upToId uint64) ([]ChangeState, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listChangeStates(db, afterId, upToId)
+}
+func (s sqlChangeStore) GetMainChange(projectId uint64, ownerId string, changeId uint64) (uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	return getMainChange(db, changeId)
+}
+func (s sqlChangeStore) CommitChange(projectId uint64, ownerId string, changeId uint64, message string, files []ChangeFile, baseChangeId *uint64) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	s.transitionMu.Lock()
+	defer s.transitionMu.Unlock()
+	return commitChange(db, changeId, message, files, baseChangeId)
+}
+func (s sqlChangeStore) AbortChange(projectId uint64, ownerId string, changeId uint64) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	s.transitionMu.Lock()
+	defer s.transitionMu.Unlock()
+	return abortChange(db, changeId)
+}
+func (s sqlChangeStore) ListOpenChanges(projectId uint64, ownerId string) ([]Change, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listOpenChanges(db)
+}
+func (s sqlChangeStore) AddChangeFiles(projectId uint64, ownerId string, changeId uint64, files []ChangeFile) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	return addChangeFiles(db, changeId, files)
+}
+func (s sqlChangeStore) GetChange(projectId uint64, ownerId string, changeId uint64) (Change, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return Change{}, err
+	}
+	return getChange(db, changeId)
+}
+func (s sqlChangeStore) ListChanges(projectId uint64, ownerId string, beforeId uint64, limit int) ([]Change, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listChanges(db, beforeId, limit)
+}
+func (s sqlChangeStore) ListCommittedChanges(projectId uint64, ownerId string) ([]uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listCommittedChanges(db)
+}
+func (s sqlChangeStore) ListCommits(projectId uint64, ownerId string) ([]Commit, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listCommits(db)
+}
+func (s sqlChangeStore) ListPathChanges(projectId uint64, ownerId string) (map[uint64][]uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listPathChanges(db)
+}
+func (s sqlChangeStore) GetBaselineChange(projectId uint64, ownerId string) (uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	return getBaselineChange(db)
+}
+func (s sqlChangeStore) SetBaselineChange(projectId uint64, ownerId string, changeId uint64) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	return setBaselineChange(db, changeId)
+}
+func (s sqlChangeStore) CreateBranch(projectId uint64, ownerId string, name string, parent string) (Branch, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return Branch{}, err
+	}
+	s.transitionMu.Lock()
+	defer s.transitionMu.Unlock()
+	return createBranch(db, name, parent)
+}
+func (s sqlChangeStore) GetBranch(projectId uint64, ownerId string, name string) (Branch, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return Branch{}, err
+	}
+	return getBranch(db, name)
+}
+func (s sqlChangeStore) ListBranches(projectId uint64, ownerId string) ([]Branch, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listBranches(db)
+}
+func (s sqlChangeStore) GetBranchHead(projectId uint64, ownerId string, branch string) (uint64, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	return getBranchHead(db, branch)
+}
+func (s sqlChangeStore) SetBranchMergeBase(projectId uint64, ownerId string, name string, changeId uint64) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	return setBranchMergeBase(db, name, changeId)
+}
+func (s sqlChangeStore) CreateTag(projectId uint64, ownerId string, name string, changeId uint64, authorId string, message string) (Tag, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return Tag{}, err
+	}
+	s.transitionMu.Lock()
+	defer s.transitionMu.Unlock()
+	return createTag(db, name, changeId, authorId, message)
+}
+func (s sqlChangeStore) GetTag(projectId uint64, ownerId string, name string) (Tag, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return Tag{}, err
+	}
+	return getTag(db, name)
+}
+func (s sqlChangeStore) ListTags(projectId uint64, ownerId string) ([]Tag, error) {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	return listTags(db)
+}
+func (s sqlChangeStore) DeleteTag(projectId uint64, ownerId string, name string) error {
+	db, err := s.projectDB(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	return deleteTag(db, name)
+}
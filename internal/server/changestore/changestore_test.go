@@ -0,0 +1,311 @@
+package changestore
+
+import (
+	"database/sql"
+	"math"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalChangeStoreMetadata(t *testing.T) {
+	testChangeStoreMetadata(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreMetadata(t *testing.T) {
+	testChangeStoreMetadata(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreMetadata(t *testing.T, store ChangeStore) {
+	for i := 0; i < 3; i++ {
+		changeId, err := store.AddChange(1, "owner", MainBranch, "author", "", "host", nil)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), changeId)
+	}
+
+	require.NoError(t, store.AddChangeFiles(1, "owner", 2, []ChangeFile{{PathHash: math.MaxUint64}, {PathHash: 7}}))
+	require.NoError(t, store.AddChangeFiles(1, "owner", 2, []ChangeFile{{PathHash: math.MaxUint64, Path: "a.txt"}}))
+	require.NoError(t, store.CommitChange(1, "owner", 2, "second change", []ChangeFile{{PathHash: math.MaxUint64}}, nil))
+
+	change, err := store.GetChange(1, "owner", 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), change.Id)
+	require.Equal(t, "author", change.AuthorId)
+	require.Equal(t, "host", change.Hostname)
+	require.Equal(t, "second change", change.Message)
+	require.True(t, change.Committed)
+	require.Equal(t, []ChangeFile{{PathHash: 7}, {PathHash: math.MaxUint64, Path: "a.txt"}}, change.Files)
+
+	changes, err := store.ListChanges(1, "owner", 0, 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, uint64(3), changes[0].Id)
+	require.False(t, changes[0].Committed)
+	require.Equal(t, uint64(2), changes[1].Id)
+
+	changes, err = store.ListChanges(1, "owner", 2, 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, uint64(1), changes[0].Id)
+}
+
+func TestLocalChangeStoreTransitions(t *testing.T) {
+	testChangeStoreTransitions(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreTransitions(t *testing.T) {
+	testChangeStoreTransitions(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreTransitions(t *testing.T, store ChangeStore) {
+	for i := 0; i < 3; i++ {
+		_, err := store.AddChange(1, "owner", MainBranch, "author", "", "", nil)
+		require.NoError(t, err)
+	}
+
+	require.ErrorIs(t, store.CommitChange(1, "owner", 4, "", nil, nil), sql.ErrNoRows)
+	require.ErrorIs(t, store.AbortChange(1, "owner", 4), sql.ErrNoRows)
+
+	require.NoError(t, store.CommitChange(1, "owner", 1, "", nil, nil))
+	require.ErrorIs(t, store.CommitChange(1, "owner", 1, "", nil, nil), ErrChangeNotOpen)
+	require.ErrorIs(t, store.AbortChange(1, "owner", 1), ErrChangeNotOpen)
+
+	require.NoError(t, store.AbortChange(1, "owner", 2))
+	require.ErrorIs(t, store.AbortChange(1, "owner", 2), ErrChangeNotOpen)
+	// A failed commit leaves no trace of its files.
+	require.ErrorIs(t, store.CommitChange(1, "owner", 2, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}}, nil), ErrChangeNotOpen)
+
+	change, err := store.GetChange(1, "owner", 2)
+	require.NoError(t, err)
+	require.True(t, change.Aborted)
+	require.False(t, change.Committed)
+	require.Empty(t, change.Files)
+
+	open, err := store.ListOpenChanges(1, "owner")
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	require.Equal(t, uint64(3), open[0].Id)
+
+	committed, err := store.ListCommittedChanges(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, committed)
+}
+
+func TestLocalChangeStoreConflicts(t *testing.T) {
+	testChangeStoreConflicts(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreConflicts(t *testing.T) {
+	testChangeStoreConflicts(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreConflicts(t *testing.T, store ChangeStore) {
+	base := uint64(0)
+	for i := 0; i < 4; i++ {
+		_, err := store.AddChange(1, "owner", MainBranch, "author", "", "", &base)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, store.CommitChange(1, "owner", 1, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}}, nil))
+
+	// Change 2 is based on change 0 and touches a.txt, which change 1 changed since.
+	err := store.CommitChange(1, "owner", 2, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}, {PathHash: 2, Path: "b.txt"}}, nil)
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, uint64(0), conflict.BaseChangeId)
+	require.Equal(t, uint64(1), conflict.LatestChangeId)
+	require.Equal(t, []string{"a.txt"}, conflict.Paths)
+
+	// Nothing of the rejected commit is kept, so it can be retried on a newer base.
+	change, err := store.GetChange(1, "owner", 2)
+	require.NoError(t, err)
+	require.False(t, change.Committed)
+	require.Empty(t, change.Files)
+	newBase := uint64(1)
+	require.NoError(t, store.CommitChange(1, "owner", 2, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}}, &newBase))
+
+	// Changes to other paths don't conflict.
+	require.NoError(t, store.CommitChange(1, "owner", 3, "", []ChangeFile{{PathHash: 3, Path: "c.txt"}}, nil))
+
+	// Neither do changes without a base.
+	_, err = store.AddChange(1, "owner", MainBranch, "author", "", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, store.CommitChange(1, "owner", 5, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}}, nil))
+
+	// Change 6 stays open while change 7 is committed and used as the base of change
+	// 8. Committing change 6 afterwards changes a file that change 8 didn't see.
+	base = 5
+	for i := 0; i < 2; i++ {
+		_, err = store.AddChange(1, "owner", MainBranch, "author", "", "", &base)
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.CommitChange(1, "owner", 7, "", []ChangeFile{{PathHash: 7, Path: "g.txt"}}, nil))
+	base = 7
+	_, err = store.AddChange(1, "owner", MainBranch, "author", "", "", &base)
+	require.NoError(t, err)
+	require.NoError(t, store.CommitChange(1, "owner", 6, "", []ChangeFile{{PathHash: 6, Path: "f.txt"}}, nil))
+	err = store.CommitChange(1, "owner", 8, "", []ChangeFile{{PathHash: 6, Path: "f.txt"}}, nil)
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, uint64(6), conflict.LatestChangeId)
+
+	latest, err := store.GetLatestCommittedChange(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), latest)
+
+	states, err := store.ListChangeStates(1, "owner", 5, 8)
+	require.NoError(t, err)
+	require.Equal(t, []ChangeState{{Id: 6, Committed: true}, {Id: 7, Committed: true}, {Id: 8}}, states)
+}
+
+func TestLocalChangeStoreBaseline(t *testing.T) {
+	testChangeStoreBaseline(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreBaseline(t *testing.T) {
+	testChangeStoreBaseline(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreBaseline(t *testing.T, store ChangeStore) {
+	for i := 0; i < 3; i++ {
+		_, err := store.AddChange(1, "owner", MainBranch, "author", "", "", nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.CommitChange(1, "owner", 2, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}}, nil))
+	require.NoError(t, store.CommitChange(1, "owner", 1, "", []ChangeFile{{PathHash: 1, Path: "a.txt"}, {PathHash: 2, Path: "b.txt"}}, nil))
+
+	commits, err := store.ListCommits(1, "owner")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, uint64(1), commits[0].ChangeId)
+	require.Equal(t, uint64(2), commits[1].ChangeId)
+
+	pathChanges, err := store.ListPathChanges(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][]uint64{1: {1, 2}, 2: {1}}, pathChanges)
+
+	baseline, err := store.GetBaselineChange(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), baseline)
+	require.NoError(t, store.SetBaselineChange(1, "owner", 1))
+	require.NoError(t, store.SetBaselineChange(1, "owner", 2))
+	baseline, err = store.GetBaselineChange(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), baseline)
+}
+
+func TestLocalChangeStoreBranches(t *testing.T) {
+	testChangeStoreBranches(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreBranches(t *testing.T) {
+	testChangeStoreBranches(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreBranches(t *testing.T, store ChangeStore) {
+	commit := func(branch string, file ChangeFile) {
+		changeId, err := store.AddChange(1, "owner", branch, "author", "", "", nil)
+		require.NoError(t, err)
+		require.NoError(t, store.CommitChange(1, "owner", changeId, "", []ChangeFile{file}, nil))
+	}
+	a, b := ChangeFile{PathHash: 1, Path: "a.txt"}, ChangeFile{PathHash: 2, Path: "b.txt"}
+
+	commit(MainBranch, a)
+	_, err := store.AddChange(1, "owner", "feature", "author", "", "", nil)
+	require.ErrorIs(t, err, ErrBranchNotFound)
+	feature, err := store.CreateBranch(1, "owner", "feature", MainBranch)
+	require.NoError(t, err)
+	require.Equal(t, Branch{Name: "feature", Parent: MainBranch, ForkChangeId: 1, MergeBaseChangeId: 1, HeadChangeId: 1, Timestamp: feature.Timestamp}, feature)
+	_, err = store.CreateBranch(1, "owner", "feature", MainBranch)
+	require.ErrorIs(t, err, ErrBranchExists)
+	_, err = store.CreateBranch(1, "owner", MainBranch, "feature")
+	require.ErrorIs(t, err, ErrBranchExists)
+	_, err = store.CreateBranch(1, "owner", "other", "missing")
+	require.ErrorIs(t, err, ErrBranchNotFound)
+
+	// Main and the branch both touch a.txt without conflicting, since neither sees the
+	// change of the other.
+	commit("feature", a)
+	base := uint64(1)
+	changeId, err := store.AddChange(1, "owner", MainBranch, "author", "", "", &base)
+	require.NoError(t, err)
+	require.NoError(t, store.CommitChange(1, "owner", changeId, "", []ChangeFile{a}, nil))
+	_, err = store.CreateBranch(1, "owner", "nested", "feature")
+	require.NoError(t, err)
+	commit("nested", b)
+
+	head, err := store.GetBranchHead(1, "owner", "feature")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), head)
+	latest, err := store.GetLatestCommittedChange(1, "owner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), latest)
+
+	states, err := store.ListChangeStates(1, "owner", 0, 4)
+	require.NoError(t, err)
+	require.Equal(t, []ChangeState{{Id: 1, Committed: true}, {Id: 2, Committed: true}, {Id: 4, Committed: true}}, states)
+	states, err = store.ListChangeStates(1, "owner", 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, []ChangeState{{Id: 1, Committed: true}, {Id: 3, Committed: true}}, states)
+	mainChangeId, err := store.GetMainChange(1, "owner", 4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), mainChangeId)
+
+	change, err := store.GetChange(1, "owner", 4)
+	require.NoError(t, err)
+	require.Equal(t, "nested", change.Branch)
+
+	require.NoError(t, store.SetBranchMergeBase(1, "owner", "feature", 2))
+	branches, err := store.ListBranches(1, "owner")
+	require.NoError(t, err)
+	require.Len(t, branches, 2)
+	require.Equal(t, "feature", branches[0].Name)
+	require.Equal(t, uint64(2), branches[0].MergeBaseChangeId)
+	require.Equal(t, "nested", branches[1].Name)
+	require.Equal(t, "feature", branches[1].Parent)
+	require.Equal(t, uint64(4), branches[1].HeadChangeId)
+}
+
+func TestLocalChangeStoreTags(t *testing.T) {
+	testChangeStoreTags(t, NewLocalChangeStore(t.TempDir()))
+}
+
+func TestMemoryChangeStoreTags(t *testing.T) {
+	testChangeStoreTags(t, NewMemoryChangeStore())
+}
+
+func testChangeStoreTags(t *testing.T, store ChangeStore) {
+	for i := 0; i < 2; i++ {
+		changeId, err := store.AddChange(1, "owner", MainBranch, "author", "", "", nil)
+		require.NoError(t, err)
+		require.NoError(t, store.CommitChange(1, "owner", changeId, "", nil, nil))
+	}
+	openId, err := store.AddChange(1, "owner", MainBranch, "author", "", "", nil)
+	require.NoError(t, err)
+
+	release, err := store.CreateTag(1, "owner", "v2", 2, "author", "release")
+	require.NoError(t, err)
+	require.Equal(t, Tag{Name: "v2", ChangeId: 2, Message: "release", AuthorId: "author", Timestamp: release.Timestamp}, release)
+	_, err = store.CreateTag(1, "owner", "v1", 1, "author", "")
+	require.NoError(t, err)
+	_, err = store.CreateTag(1, "owner", "v2", 1, "author", "")
+	require.ErrorIs(t, err, ErrTagExists)
+	_, err = store.CreateTag(1, "owner", "open", openId, "author", "")
+	require.ErrorIs(t, err, ErrChangeNotCommitted)
+	_, err = store.CreateTag(1, "owner", "missing", 10, "author", "")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	tag, err := store.GetTag(1, "owner", "v2")
+	require.NoError(t, err)
+	require.Equal(t, release, tag)
+	tags, err := store.ListTags(1, "owner")
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	require.Equal(t, "v1", tags[0].Name)
+	require.Equal(t, "v2", tags[1].Name)
+
+	require.NoError(t, store.DeleteTag(1, "owner", "v1"))
+	require.ErrorIs(t, store.DeleteTag(1, "owner", "v1"), ErrTagNotFound)
+	_, err = store.GetTag(1, "owner", "v1")
+	require.ErrorIs(t, err, ErrTagNotFound)
+}
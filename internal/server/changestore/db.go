@@ -3,15 +3,65 @@ package changestore
 import (
 	"database/sql"
 	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"time"
 )
 
 func setup(db *sql.DB) error {
 	sqlStmt := `
 	CREATE TABLE IF NOT EXISTS committed_changes (change_id INTEGER, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
-	CREATE TABLE IF NOT EXISTS changes (id INTEGER, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
+	CREATE TABLE IF NOT EXISTS aborted_changes (change_id INTEGER, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
+	CREATE TABLE IF NOT EXISTS changes (id INTEGER, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP, author_id TEXT, message TEXT, hostname TEXT);
+	CREATE TABLE IF NOT EXISTS change_files (change_id INTEGER, path_hash INTEGER, path TEXT, UNIQUE(change_id, path_hash));
+	CREATE TABLE IF NOT EXISTS baseline (id INTEGER PRIMARY KEY CHECK (id = 0), change_id INTEGER);
+	CREATE TABLE IF NOT EXISTS branches (name TEXT PRIMARY KEY, parent TEXT, fork_change_id INTEGER, merge_base_change_id INTEGER, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
+	CREATE TABLE IF NOT EXISTS tags (name TEXT PRIMARY KEY, change_id INTEGER, message TEXT, author_id TEXT, timestamp DATETIME DEFAULT CURRENT_TIMESTAMP);
 	`
 	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+
+	// Projects created before change metadata was recorded are missing these columns.
+	for _, column := range []string{"author_id", "message", "hostname"} {
+		err = addColumnIfMissing(db, "changes", column, "TEXT")
+		if err != nil {
+			return err
+		}
+	}
+	err = addColumnIfMissing(db, "changes", "base_change_id", "INTEGER")
+	if err != nil {
+		return err
+	}
+	// Changes made before there were branches are on the main branch.
+	return addColumnIfMissing(db, "changes", "branch", "TEXT")
+}
+
+func addColumnIfMissing(db *sql.DB, table string, column string, columnType string) error {
+	rows, err := db.Query(fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, columnType))
 	return err
 }
 
@@ -30,13 +80,133 @@ func getCurrentChange(db *sql.DB) (uint64, time.Time, error) {
 	return id, timestamp, err
 }
 
-func addChange(db *sql.DB) (uint64, error) {
+// branchColumn is how a branch is stored in the branch column of changes, where the
+// main branch is empty, or NULL for changes made before there were branches.
+func branchColumn(branch string) string {
+	if branch == MainBranch {
+		return ""
+	}
+	return branch
+}
+
+func branchName(column string) string {
+	if column == "" {
+		return MainBranch
+	}
+	return column
+}
+
+func getLatestCommittedChange(db *sql.DB) (uint64, error) {
+	return getBranchHead(db, MainBranch)
+}
+
+// getBranchHead returns the latest change committed to a branch, or the change it
+// was forked from if nothing was committed to it yet.
+func getBranchHead(db querier, branch string) (uint64, error) {
+	var id sql.NullInt64
+	err := db.QueryRow(`SELECT MAX(cc.change_id) FROM committed_changes AS cc
+		JOIN changes AS c ON c.id = cc.change_id
+		WHERE COALESCE(c.branch, '') = ?`, branchColumn(branch)).Scan(&id)
+	if err != nil || id.Valid || branchColumn(branch) == "" {
+		return uint64(id.Int64), err
+	}
+	var forkChangeId uint64
+	err = db.QueryRow("SELECT fork_change_id FROM branches WHERE name = ?", branch).Scan(&forkChangeId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrBranchNotFound
+	}
+	return forkChangeId, err
+}
+
+// segment is the part of a branch up to and including upToId.
+type segment struct {
+	branch string
+	upToId uint64
+}
+
+// ancestry returns the history that a change builds on, newest first: its own branch
+// up to the change, then the branch that one was forked from up to the fork and so on
+// down to the main branch. A change that doesn't exist is taken to be on the main
+// branch.
+func ancestry(db querier, changeId uint64) ([]segment, error) {
+	var branch sql.NullString
+	err := db.QueryRow("SELECT branch FROM changes WHERE id = ?", changeId).Scan(&branch)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	segments := make([]segment, 0, 1)
+	current := segment{branch: branch.String, upToId: changeId}
+	for current.branch != "" {
+		segments = append(segments, current)
+		var parent sql.NullString
+		err = db.QueryRow("SELECT parent, fork_change_id FROM branches WHERE name = ?", current.branch).Scan(&parent, &current.upToId)
+		if err != nil {
+			return nil, err
+		}
+		current.branch = parent.String
+	}
+	return append(segments, current), nil
+}
+
+// getMainChange returns the latest change of the main branch that changeId builds
+// on, which is changeId itself for changes on the main branch.
+func getMainChange(db *sql.DB, changeId uint64) (uint64, error) {
+	segments, err := ancestry(db, changeId)
+	if err != nil {
+		return 0, err
+	}
+	return segments[len(segments)-1].upToId, nil
+}
+
+// listChangeStates returns the state of every change after afterId in the ancestry of
+// upToId, up to and including upToId, in ascending order.
+func listChangeStates(db *sql.DB, afterId uint64, upToId uint64) ([]ChangeState, error) {
+	segments, err := ancestry(db, upToId)
+	if err != nil {
+		return nil, err
+	}
+	conditions := make([]string, 0, len(segments))
+	args := []any{int64(afterId)}
+	for _, segment := range segments {
+		conditions = append(conditions, "(COALESCE(c.branch, '') = ? AND c.id <= ?)")
+		args = append(args, segment.branch, int64(segment.upToId))
+	}
+
+	rows, err := db.Query(`SELECT c.id,
+		EXISTS(SELECT 1 FROM committed_changes WHERE change_id = c.id),
+		EXISTS(SELECT 1 FROM aborted_changes WHERE change_id = c.id)
+		FROM changes AS c WHERE c.id > ? AND (`+strings.Join(conditions, " OR ")+`) ORDER BY c.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states := make([]ChangeState, 0)
+	for rows.Next() {
+		var state ChangeState
+		err = rows.Scan(&state.Id, &state.Committed, &state.Aborted)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, rows.Err()
+}
+
+func addChange(db *sql.DB, branch string, authorId string, message string, hostname string, baseChangeId *uint64) (uint64, error) {
+	if branchColumn(branch) != "" {
+		_, err := getBranch(db, branch)
+		if err != nil {
+			return 0, err
+		}
+	}
 	changeId, _, err := getCurrentChange(db)
 	if !errors.Is(sql.ErrNoRows, err) && err != nil {
 		return 0, err
 	}
 	newId := changeId + 1
-	_, err = db.Exec("INSERT INTO changes(id) VALUES(?)", newId)
+	_, err = db.Exec("INSERT INTO changes(id, author_id, message, hostname, base_change_id, branch) VALUES(?, ?, ?, ?, ?, ?)", newId, authorId, message, hostname, nullableId(baseChangeId), branchColumn(branch))
 	if err != nil {
 		return 0, err
 	}
@@ -44,13 +214,282 @@ func addChange(db *sql.DB) (uint64, error) {
 	return uint64(newId), nil
 }
 
-func commitChange(db *sql.DB, changeId uint64) error {
-	_, err := db.Exec("INSERT INTO committed_changes(change_id) VALUES(?)", changeId)
+func nullableId(id *uint64) sql.NullInt64 {
+	if id == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*id), Valid: true}
+}
+
+// commitChange moves an open change to committed along with the files written in it.
+// Nothing is recorded unless the whole transition succeeds. A nil baseChangeId falls
+// back to the base the change was created with.
+func commitChange(db *sql.DB, changeId uint64, message string, files []ChangeFile, baseChangeId *uint64) error {
+	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return err
+	err = requireOpen(tx, changeId)
+	if err != nil {
+		return err
+	}
+	err = addChangeFiles(tx, changeId, files)
+	if err != nil {
+		return err
+	}
+	if baseChangeId == nil {
+		var base sql.NullInt64
+		err = tx.QueryRow("SELECT base_change_id FROM changes WHERE id = ?", changeId).Scan(&base)
+		if err != nil {
+			return err
+		}
+		if base.Valid {
+			id := uint64(base.Int64)
+			baseChangeId = &id
+		}
+	}
+	if baseChangeId != nil {
+		err = checkConflicts(tx, changeId, *baseChangeId)
+		if err != nil {
+			return err
+		}
+	}
+	if message != "" {
+		_, err = tx.Exec("UPDATE changes SET message = ? WHERE id = ?", message, changeId)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec("INSERT INTO committed_changes(change_id) VALUES(?)", changeId)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// checkConflicts returns a *ConflictError if a change committed to the same branch
+// after baseChangeId touched a file that changeId touches. Changes are compared in the
+// order they were committed rather than by id, since a change with a lower id than the
+// base can be committed after it. A base that was never committed stands for the last
+// change committed up to it.
+func checkConflicts(tx *sql.Tx, changeId uint64, baseChangeId uint64) error {
+	rows, err := tx.Query(`SELECT cf.change_id, cf.path_hash, COALESCE(mine.path, ''), COALESCE(cf.path, '')
+		FROM change_files AS cf
+		JOIN committed_changes AS cc ON cc.change_id = cf.change_id
+		JOIN changes AS other ON other.id = cf.change_id
+		JOIN change_files AS mine ON mine.path_hash = cf.path_hash AND mine.change_id = ?
+		WHERE cf.change_id != ?
+		AND COALESCE(other.branch, '') = (SELECT COALESCE(branch, '') FROM changes WHERE id = ?)
+		AND cc.rowid > COALESCE(
+			(SELECT rowid FROM committed_changes WHERE change_id = ?),
+			(SELECT MAX(rowid) FROM committed_changes WHERE change_id <= ?),
+			0)
+		ORDER BY cc.rowid`, changeId, changeId, changeId, baseChangeId, baseChangeId)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	conflict := &ConflictError{BaseChangeId: baseChangeId}
+	seen := make(map[int64]bool)
+	for rows.Next() {
+		var otherId uint64
+		var pathHash int64
+		var path, otherPath string
+		err = rows.Scan(&otherId, &pathHash, &path, &otherPath)
+		if err != nil {
+			return err
+		}
+		conflict.LatestChangeId = otherId
+		if seen[pathHash] {
+			continue
+		}
+		seen[pathHash] = true
+		if path == "" {
+			path = otherPath
+		}
+		if path == "" {
+			path = fmt.Sprintf("<path hash %d>", uint64(pathHash))
+		}
+		conflict.Paths = append(conflict.Paths, path)
+	}
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+	if len(conflict.Paths) > 0 {
+		sort.Strings(conflict.Paths)
+		return conflict
+	}
+	return nil
+}
+
+func abortChange(db *sql.DB, changeId uint64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = requireOpen(tx, changeId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT INTO aborted_changes(change_id) VALUES(?)", changeId)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// requireOpen returns sql.ErrNoRows if a change does not exist and ErrChangeNotOpen if
+// it was already committed or aborted.
+func requireOpen(tx *sql.Tx, changeId uint64) error {
+	var committed, aborted bool
+	err := tx.QueryRow(`SELECT
+		EXISTS(SELECT 1 FROM committed_changes WHERE change_id = c.id),
+		EXISTS(SELECT 1 FROM aborted_changes WHERE change_id = c.id)
+		FROM changes AS c WHERE c.id = ?`, changeId).Scan(&committed, &aborted)
+	if err != nil {
+		return err
+	}
+	if committed || aborted {
+		return ErrChangeNotOpen
+	}
+	return nil
+}
+
+// listOpenChanges returns the changes that were neither committed nor aborted, oldest
+// first.
+func listOpenChanges(db *sql.DB) ([]Change, error) {
+	rows, err := db.Query(selectChanges + ` WHERE cc.change_id IS NULL
+		AND NOT EXISTS(SELECT 1 FROM aborted_changes WHERE change_id = c.id) ORDER BY c.id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]Change, 0)
+	for rows.Next() {
+		change, err := scanChange(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// addChangeFiles records files written in a change. Path hashes are stored as
+// int64 since sqlite integers are signed. A known path is never replaced by an
+// empty one.
+func addChangeFiles(db execer, changeId uint64, files []ChangeFile) error {
+	for _, file := range files {
+		_, err := db.Exec(`INSERT INTO change_files(change_id, path_hash, path) VALUES(?, ?, ?)
+			ON CONFLICT(change_id, path_hash) DO UPDATE SET path = excluded.path WHERE excluded.path != ''`,
+			changeId, int64(file.PathHash), file.Path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func listChangeFiles(db *sql.DB, changeId uint64) ([]ChangeFile, error) {
+	rows, err := db.Query("SELECT path_hash, path FROM change_files WHERE change_id = ? ORDER BY path", changeId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	files := make([]ChangeFile, 0)
+	for rows.Next() {
+		var pathHash int64
+		var path string
+		err = rows.Scan(&pathHash, &path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, ChangeFile{PathHash: uint64(pathHash), Path: path})
+	}
+	return files, rows.Err()
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// querier is implemented by both *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+const selectChanges = `SELECT c.id, COALESCE(c.author_id, ''), COALESCE(c.message, ''), COALESCE(c.hostname, ''), c.base_change_id, COALESCE(c.branch, ''), c.timestamp, cc.timestamp,
+	EXISTS(SELECT 1 FROM aborted_changes WHERE change_id = c.id)
+	FROM changes AS c LEFT JOIN committed_changes AS cc ON cc.change_id = c.id`
+
+func scanChange(scan func(dest ...any) error) (Change, error) {
+	var change Change
+	var baseChangeId sql.NullInt64
+	var commitTimestamp sql.NullTime
+	var branch string
+	err := scan(&change.Id, &change.AuthorId, &change.Message, &change.Hostname, &baseChangeId, &branch, &change.Timestamp, &commitTimestamp, &change.Aborted)
+	if err != nil {
+		return Change{}, err
+	}
+	change.Branch = branchName(branch)
+	if baseChangeId.Valid {
+		id := uint64(baseChangeId.Int64)
+		change.BaseChangeId = &id
+	}
+	change.Committed = commitTimestamp.Valid
+	change.CommitTimestamp = commitTimestamp.Time
+	return change, nil
+}
+
+func getChange(db *sql.DB, changeId uint64) (Change, error) {
+	row := db.QueryRow(selectChanges+" WHERE c.id = ?", changeId)
+	change, err := scanChange(row.Scan)
+	if err != nil {
+		return Change{}, err
+	}
+	change.Files, err = listChangeFiles(db, changeId)
+	return change, err
+}
+
+// listChanges returns up to limit changes with ids below beforeId, newest first. A
+// beforeId of 0 starts from the latest change.
+func listChanges(db *sql.DB, beforeId uint64, limit int) ([]Change, error) {
+	if beforeId == 0 {
+		beforeId = math.MaxInt64
+	}
+	rows, err := db.Query(selectChanges+" WHERE c.id < ? ORDER BY c.id DESC LIMIT ?", int64(beforeId), limit)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]Change, 0)
+	for rows.Next() {
+		change, err := scanChange(rows.Scan)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	for i := range changes {
+		changes[i].Files, err = listChangeFiles(db, changes[i].Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
 }
 
 func listCommittedChanges(db *sql.DB) ([]uint64, error) {
@@ -72,3 +511,256 @@ func listCommittedChanges(db *sql.DB) ([]uint64, error) {
 	}
 	return changeIds, nil
 }
+
+// listCommits returns every commit in ascending order of change id.
+func listCommits(db *sql.DB) ([]Commit, error) {
+	rows, err := db.Query(`SELECT cc.change_id, cc.timestamp, COALESCE(c.branch, '') FROM committed_changes AS cc
+		JOIN changes AS c ON c.id = cc.change_id ORDER BY cc.change_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	commits := make([]Commit, 0)
+	for rows.Next() {
+		var commit Commit
+		var branch string
+		err = rows.Scan(&commit.ChangeId, &commit.Timestamp, &branch)
+		if err != nil {
+			return nil, err
+		}
+		commit.Branch = branchName(branch)
+		commits = append(commits, commit)
+	}
+	return commits, rows.Err()
+}
+
+// listPathChanges returns the ids of the changes that wrote each path hash, in
+// ascending order.
+func listPathChanges(db *sql.DB) (map[uint64][]uint64, error) {
+	rows, err := db.Query("SELECT path_hash, change_id FROM change_files ORDER BY change_id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pathChanges := make(map[uint64][]uint64)
+	for rows.Next() {
+		var pathHash int64
+		var changeId uint64
+		err = rows.Scan(&pathHash, &changeId)
+		if err != nil {
+			return nil, err
+		}
+		pathChanges[uint64(pathHash)] = append(pathChanges[uint64(pathHash)], changeId)
+	}
+	return pathChanges, rows.Err()
+}
+
+func getBaselineChange(db *sql.DB) (uint64, error) {
+	var changeId uint64
+	err := db.QueryRow("SELECT change_id FROM baseline WHERE id = 0").Scan(&changeId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return changeId, err
+}
+
+func setBaselineChange(db *sql.DB, changeId uint64) error {
+	_, err := db.Exec("INSERT INTO baseline(id, change_id) VALUES(0, ?) ON CONFLICT(id) DO UPDATE SET change_id = excluded.change_id", changeId)
+	return err
+}
+
+// createBranch forks a new branch from the head of parent.
+func createBranch(db *sql.DB, name string, parent string) (Branch, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return Branch{}, err
+	}
+	defer tx.Rollback()
+
+	if branchColumn(name) == "" {
+		return Branch{}, ErrBranchExists
+	}
+	var exists bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM branches WHERE name = ?)", name).Scan(&exists)
+	if err != nil {
+		return Branch{}, err
+	}
+	if exists {
+		return Branch{}, ErrBranchExists
+	}
+	forkChangeId, err := getBranchHead(tx, parent)
+	if err != nil {
+		return Branch{}, err
+	}
+	_, err = tx.Exec("INSERT INTO branches(name, parent, fork_change_id, merge_base_change_id) VALUES(?, ?, ?, ?)",
+		name, branchColumn(parent), forkChangeId, forkChangeId)
+	if err != nil {
+		return Branch{}, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return Branch{}, err
+	}
+	return getBranch(db, name)
+}
+
+const selectBranches = "SELECT name, COALESCE(parent, ''), fork_change_id, merge_base_change_id, timestamp FROM branches"
+
+func scanBranch(db *sql.DB, scan func(dest ...any) error) (Branch, error) {
+	var branch Branch
+	var parent string
+	err := scan(&branch.Name, &parent, &branch.ForkChangeId, &branch.MergeBaseChangeId, &branch.Timestamp)
+	if err != nil {
+		return Branch{}, err
+	}
+	branch.Parent = branchName(parent)
+	branch.HeadChangeId, err = getBranchHead(db, branch.Name)
+	return branch, err
+}
+
+func getBranch(db *sql.DB, name string) (Branch, error) {
+	branch, err := scanBranch(db, db.QueryRow(selectBranches+" WHERE name = ?", name).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Branch{}, ErrBranchNotFound
+	}
+	return branch, err
+}
+
+// listBranches returns every branch other than the main one, oldest first.
+func listBranches(db *sql.DB) ([]Branch, error) {
+	rows, err := db.Query(selectBranches + " ORDER BY rowid")
+	if err != nil {
+		return nil, err
+	}
+	branches := make([]Branch, 0)
+	for rows.Next() {
+		var branch Branch
+		var parent string
+		err = rows.Scan(&branch.Name, &parent, &branch.ForkChangeId, &branch.MergeBaseChangeId, &branch.Timestamp)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		branch.Parent = branchName(parent)
+		branches = append(branches, branch)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	for i := range branches {
+		branches[i].HeadChangeId, err = getBranchHead(db, branches[i].Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return branches, nil
+}
+
+func setBranchMergeBase(db *sql.DB, name string, changeId uint64) error {
+	res, err := db.Exec("UPDATE branches SET merge_base_change_id = ? WHERE name = ?", changeId, name)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrBranchNotFound
+	}
+	return nil
+}
+
+// createTag names a committed change.
+func createTag(db *sql.DB, name string, changeId uint64, authorId string, message string) (Tag, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return Tag{}, err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM tags WHERE name = ?)", name).Scan(&exists)
+	if err != nil {
+		return Tag{}, err
+	}
+	if exists {
+		return Tag{}, ErrTagExists
+	}
+	err = tx.QueryRow("SELECT id FROM changes WHERE id = ?", changeId).Scan(&changeId)
+	if err != nil {
+		return Tag{}, err
+	}
+	var committed bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM committed_changes WHERE change_id = ?)", changeId).Scan(&committed)
+	if err != nil {
+		return Tag{}, err
+	}
+	if !committed {
+		return Tag{}, ErrChangeNotCommitted
+	}
+	_, err = tx.Exec("INSERT INTO tags(name, change_id, message, author_id) VALUES(?, ?, ?, ?)", name, changeId, message, authorId)
+	if err != nil {
+		return Tag{}, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return Tag{}, err
+	}
+	return getTag(db, name)
+}
+
+const selectTags = "SELECT name, change_id, COALESCE(message, ''), COALESCE(author_id, ''), timestamp FROM tags"
+
+func scanTag(scan func(dest ...any) error) (Tag, error) {
+	var tag Tag
+	err := scan(&tag.Name, &tag.ChangeId, &tag.Message, &tag.AuthorId, &tag.Timestamp)
+	return tag, err
+}
+
+func getTag(db *sql.DB, name string) (Tag, error) {
+	tag, err := scanTag(db.QueryRow(selectTags+" WHERE name = ?", name).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Tag{}, ErrTagNotFound
+	}
+	return tag, err
+}
+
+// listTags returns every tag in ascending order of change id, oldest first for tags
+// of the same change.
+func listTags(db *sql.DB) ([]Tag, error) {
+	rows, err := db.Query(selectTags + " ORDER BY change_id, rowid")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make([]Tag, 0)
+	for rows.Next() {
+		tag, err := scanTag(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+func deleteTag(db *sql.DB, name string) error {
+	res, err := db.Exec("DELETE FROM tags WHERE name = ?", name)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrTagNotFound
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package changestore
+
+import (
+	"database/sql"
+)
+
+// MemoryChangeStore keeps the changes of each project in an in-memory sqlite database.
+type MemoryChangeStore struct {
+	sqlChangeStore
+}
+
+func NewMemoryChangeStore() MemoryChangeStore {
+	return MemoryChangeStore{newSqlChangeStore(func(projectId uint64, ownerId string) (*sql.DB, error) {
+		db, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			return nil, err
+		}
+		// Every connection to :memory: gets its own empty database.
+		db.SetMaxOpenConns(1)
+		return db, nil
+	}, func(projectId uint64, ownerId string) error {
+		// Closing the database was enough to discard it.
+		return nil
+	})}
+}
@@ -0,0 +1,177 @@
+package chunkstore
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ChunkStore stores blocks of file data once no matter how many files, changes or
+// projects contain them. Chunks are keyed by the SHA-256 hash of their content and
+// reference counted so that unused chunks can be removed.
+type ChunkStore interface {
+	// Put stores data if it is not already stored, adds a reference to it and returns
+	// its hash.
+	Put(data []byte) (hash []byte, err error)
+	Get(hash []byte) (data []byte, err error)
+	// Release removes a reference to a chunk, deleting it when none remain.
+	Release(hash []byte) error
+	RefCount(hash []byte) (uint64, error)
+}
+
+func Hash(data []byte) []byte {
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// LocalChunkStore keeps chunks in files below a directory, with reference counts in a
+// sqlite database alongside them that is opened on first use.
+type LocalChunkStore struct {
+	directory string
+	mu        *sync.Mutex
+	refsDB    **sql.DB
+}
+
+func NewLocalChunkStore(directory string) LocalChunkStore {
+	return LocalChunkStore{
+		directory: directory + "/chunks",
+		mu:        &sync.Mutex{},
+		refsDB:    new(*sql.DB),
+	}
+}
+
+// db returns the reference count database. It must be called with s.mu held.
+func (s LocalChunkStore) db() (*sql.DB, error) {
+	if *s.refsDB != nil {
+		return *s.refsDB, nil
+	}
+
+	err := os.MkdirAll(s.directory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", s.directory+"/refs.db")
+	if err != nil {
+		return nil, err
+	}
+	_, err = db.Exec("CREATE TABLE IF NOT EXISTS refs (hash TEXT PRIMARY KEY, refs INTEGER NOT NULL)")
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	*s.refsDB = db
+	return db, nil
+}
+
+func (s LocalChunkStore) chunkPath(hash []byte) string {
+	name := hex.EncodeToString(hash)
+	return fmt.Sprintf("%s/%s/%s.jb", s.directory, name[:2], name)
+}
+
+func (s LocalChunkStore) Put(data []byte) ([]byte, error) {
+	hash := Hash(data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, err := s.db()
+	if err != nil {
+		return nil, err
+	}
+	chunkPath := s.chunkPath(hash)
+	_, err = os.Stat(chunkPath)
+	if errors.Is(err, os.ErrNotExist) {
+		err = writeChunk(chunkPath, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec("INSERT INTO refs (hash, refs) VALUES (?, 1) ON CONFLICT(hash) DO UPDATE SET refs = refs + 1", hex.EncodeToString(hash))
+	if err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// writeChunk writes a chunk through a temporary file so that a partially written chunk
+// is never visible under its hash.
+func writeChunk(chunkPath string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(chunkPath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(chunkPath), "*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(data)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), chunkPath)
+}
+
+func (s LocalChunkStore) Get(hash []byte) ([]byte, error) {
+	return os.ReadFile(s.chunkPath(hash))
+}
+
+func (s LocalChunkStore) Release(hash []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, err := s.db()
+	if err != nil {
+		return err
+	}
+	refs, err := refCount(db, hash)
+	if err != nil {
+		return err
+	}
+	if refs > 1 {
+		_, err = db.Exec("UPDATE refs SET refs = refs - 1 WHERE hash = ?", hex.EncodeToString(hash))
+		return err
+	}
+
+	_, err = db.Exec("DELETE FROM refs WHERE hash = ?", hex.EncodeToString(hash))
+	if err != nil {
+		return err
+	}
+	err = os.Remove(s.chunkPath(hash))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s LocalChunkStore) RefCount(hash []byte) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	db, err := s.db()
+	if err != nil {
+		return 0, err
+	}
+	return refCount(db, hash)
+}
+
+func refCount(db *sql.DB, hash []byte) (uint64, error) {
+	var refs uint64
+	err := db.QueryRow("SELECT refs FROM refs WHERE hash = ?", hex.EncodeToString(hash)).Scan(&refs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return refs, err
+}
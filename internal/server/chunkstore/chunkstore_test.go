@@ -0,0 +1,48 @@
+package chunkstore
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalChunkStore(t *testing.T) {
+	testChunkStore(t, NewLocalChunkStore(t.TempDir()))
+}
+
+func TestMemoryChunkStore(t *testing.T) {
+	testChunkStore(t, NewMemoryChunkStore())
+}
+
+func testChunkStore(t *testing.T, store ChunkStore) {
+	hash, err := store.Put([]byte("vendored"))
+	require.NoError(t, err)
+	require.Equal(t, Hash([]byte("vendored")), hash)
+
+	duplicateHash, err := store.Put([]byte("vendored"))
+	require.NoError(t, err)
+	require.Equal(t, hash, duplicateHash)
+
+	refs, err := store.RefCount(hash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), refs)
+
+	data, err := store.Get(hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("vendored"), data)
+
+	require.NoError(t, store.Release(hash))
+	data, err = store.Get(hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("vendored"), data)
+
+	require.NoError(t, store.Release(hash))
+	refs, err = store.RefCount(hash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), refs)
+	_, err = store.Get(hash)
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
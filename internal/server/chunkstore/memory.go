@@ -0,0 +1,67 @@
+package chunkstore
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// MemoryChunkStore is a ChunkStore that keeps everything in memory.
+type MemoryChunkStore struct {
+	mu     *sync.Mutex
+	chunks map[string][]byte
+	refs   map[string]uint64
+}
+
+func NewMemoryChunkStore() MemoryChunkStore {
+	return MemoryChunkStore{
+		mu:     &sync.Mutex{},
+		chunks: make(map[string][]byte),
+		refs:   make(map[string]uint64),
+	}
+}
+
+func (s MemoryChunkStore) Put(data []byte) ([]byte, error) {
+	hash := Hash(data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := string(hash)
+	if _, found := s.chunks[key]; !found {
+		s.chunks[key] = append([]byte{}, data...)
+	}
+	s.refs[key]++
+	return hash, nil
+}
+
+func (s MemoryChunkStore) Get(hash []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, found := s.chunks[string(hash)]
+	if !found {
+		return nil, fmt.Errorf("chunk %x: %w", hash, os.ErrNotExist)
+	}
+	return append([]byte{}, data...), nil
+}
+
+func (s MemoryChunkStore) Release(hash []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := string(hash)
+	if s.refs[key] > 1 {
+		s.refs[key]--
+		return nil
+	}
+	delete(s.refs, key)
+	delete(s.chunks, key)
+	return nil
+}
+
+func (s MemoryChunkStore) RefCount(hash []byte) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refs[string(hash)], nil
+}
@@ -3,21 +3,36 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"io"
+	"log"
+	"os"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/cespare/xxhash"
 	"github.com/zdgeier/jamsync/gen/pb"
 	"github.com/zdgeier/jamsync/internal/rsync"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Client reads and writes a project at a change. While a change created by the
+// client is open, baseChangeId is the change the client was at before. Changes are
+// created on branch, the main branch if it is empty.
 type Client struct {
-	api       pb.JamsyncAPIClient
-	projectId uint64
-	changeId  uint64
-	committed bool
+	api               pb.JamsyncAPIClient
+	projectId         uint64
+	changeId          uint64
+	baseChangeId      uint64
+	branch            string
+	committed         bool
+	paths             []string
+	includeOpenChange bool
 }
 
 func NewClient(apiClient pb.JamsyncAPIClient, projectId uint64, changeId uint64) *Client {
@@ -28,30 +43,149 @@ func NewClient(apiClient pb.JamsyncAPIClient, projectId uint64, changeId uint64)
 	}
 }
 
-func (c *Client) CreateChange() error {
+// SetIncludeOpenChange makes downloads at the client's change include what was
+// uploaded to it while it is still open. Otherwise only committed changes are read.
+func (c *Client) SetIncludeOpenChange(include bool) {
+	c.includeOpenChange = include
+}
+
+// SetBranch makes the changes that the client creates go to branch.
+func (c *Client) SetBranch(branch string) {
+	c.branch = branch
+}
+
+// CreateChange starts a new change with an optional message describing it. The
+// change is based on the client's current change, so committing it fails with a
+// conflict if anything it touches was committed after that.
+func (c *Client) CreateChange(message string) error {
+	// The hostname only helps tell apart changes by the same author, so it is
+	// left empty when it can't be found.
+	hostname, _ := os.Hostname()
+	baseChangeId := c.changeId
 	resp, err := c.api.CreateChange(context.Background(), &pb.CreateChangeRequest{
-		ProjectId: c.projectId,
+		ProjectId:    c.projectId,
+		Message:      message,
+		Hostname:     hostname,
+		BaseChangeId: &baseChangeId,
+		Branch:       c.branch,
 	})
 	if err != nil {
 		return err
 	}
+	c.baseChangeId = baseChangeId
 	c.changeId = resp.GetChangeId()
+	c.paths = nil
 	return err
 }
 
+// CommitChange commits the current change along with the paths uploaded in it.
 func (c *Client) CommitChange() error {
 	_, err := c.api.CommitChange(context.Background(), &pb.CommitChangeRequest{
+		ProjectId:    c.projectId,
+		ChangeId:     c.changeId,
+		Paths:        c.paths,
+		BaseChangeId: &c.baseChangeId,
+	})
+	if err != nil {
+		return err
+	}
+	c.committed = true
+	return nil
+}
+
+// AbortChange discards the current change and everything uploaded in it, and moves
+// the client back to the change it was based on.
+func (c *Client) AbortChange() error {
+	_, err := c.api.AbortChange(context.Background(), &pb.AbortChangeRequest{
 		ProjectId: c.projectId,
 		ChangeId:  c.changeId,
 	})
 	if err != nil {
 		return err
 	}
-	c.committed = true
+	c.changeId = c.baseChangeId
+	c.paths = nil
 	return nil
 }
 
+// CommitConflict returns the details of err if it is a commit that was rejected
+// because its base change is behind changes to the same paths.
+func CommitConflict(err error) (*pb.CommitConflict, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if conflict, ok := detail.(*pb.CommitConflict); ok {
+			return conflict, true
+		}
+	}
+	return nil, false
+}
+
+// uploadAttempts is the number of times UploadFile tries to upload a file whose
+// upload was interrupted.
+const uploadAttempts = 5
+
+// UploadFile uploads the content of sourceReader to filePath in the current change.
+// When sourceReader is also an io.Seeker, an interrupted upload is resumed where the
+// server left off.
 func (c *Client) UploadFile(ctx context.Context, filePath string, sourceReader io.Reader) error {
+	seeker, canResume := sourceReader.(io.Seeker)
+	uploadId := newUploadId()
+	var skip uint64
+	for attempt := 1; ; attempt++ {
+		err := c.uploadOperations(ctx, filePath, sourceReader, uploadId, skip)
+		if err == nil {
+			c.paths = append(c.paths, filePath)
+			return nil
+		}
+		if !canResume || attempt == uploadAttempts || ctx.Err() != nil {
+			return err
+		}
+
+		switch status.Code(err) {
+		case codes.DataLoss:
+			// The server rejected what it got, so start over.
+			uploadId = newUploadId()
+			skip = 0
+		case codes.Unavailable, codes.Aborted, codes.Internal:
+			time.Sleep(time.Duration(attempt) * time.Second)
+			resp, statusErr := c.api.GetUploadStatus(ctx, &pb.GetUploadStatusRequest{
+				ProjectId: c.projectId,
+				UploadId:  uploadId,
+			})
+			if statusErr != nil {
+				return err
+			}
+			skip = resp.GetReceivedOps()
+		default:
+			return err
+		}
+		_, err = seeker.Seek(0, io.SeekStart)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func newUploadId() string {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// uploadOperations streams the delta from the file before the current change to the
+// content of sourceReader, skipping the first skip operations, which the server already
+// has. The delta is the same on every attempt since it is computed against the same
+// content.
+func (c *Client) uploadOperations(ctx context.Context, filePath string, sourceReader io.Reader, uploadId string, skip uint64) error {
+	// Deltas are computed against the file as it was before the change, so that
+	// uploading a file again in the same change replaces the earlier upload. Reading
+	// at the open change leaves out what was uploaded to it.
 	blockHashResp, err := c.api.ReadBlockHashes(ctx, &pb.ReadBlockHashesRequest{
 		ProjectId: c.projectId,
 		ChangeId:  c.changeId,
@@ -62,85 +196,126 @@ func (c *Client) UploadFile(ctx context.Context, filePath string, sourceReader i
 		return err
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writeStream, err := c.api.WriteOperationStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	contentHash := xxhash.New()
 	opsOut := make(chan *rsync.Operation)
+	deltaErr := make(chan error, 1)
 	rsDelta := &rsync.RSync{UniqueHasher: xxhash.New()}
 	go func() {
-		var blockCt, blockRangeCt, dataCt, bytes int
 		defer close(opsOut)
-		err := rsDelta.CreateDelta(sourceReader, PbBlockHashesToRsync(blockHashResp.GetBlockHashes()), func(op rsync.Operation) error {
-			switch op.Type {
-			case rsync.OpBlockRange:
-				blockRangeCt++
-			case rsync.OpBlock:
-				blockCt++
-			case rsync.OpData:
+		deltaErr <- rsDelta.CreateDelta(io.TeeReader(sourceReader, contentHash), PbBlockHashesToRsync(blockHashResp.GetBlockHashes()), func(op rsync.Operation) error {
+			if op.Type == rsync.OpData {
 				b := make([]byte, len(op.Data))
 				copy(b, op.Data)
 				op.Data = b
-				dataCt++
-				bytes += len(op.Data)
 			}
-			opsOut <- &op
-			return nil
+			select {
+			case opsOut <- &op:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
 		})
-		if err != nil {
-			panic(err)
-		}
 	}()
 
-	writeStream, err := c.api.WriteOperationStream(ctx)
-	if err != nil {
-		return err
+	send := func(op *pb.Operation) error {
+		op.ProjectId = c.projectId
+		op.ChangeId = c.changeId
+		op.PathHash = pathToHash(filePath)
+		op.UploadId = uploadId
+		return writeStream.Send(op)
 	}
-	sent := 0
+	var seq uint64
 	for op := range opsOut {
-		var opPbType pb.Operation_Type
-		switch op.Type {
-		case rsync.OpBlock:
-			opPbType = pb.Operation_OpBlock
-		case rsync.OpData:
-			opPbType = pb.Operation_OpData
-		case rsync.OpHash:
-			opPbType = pb.Operation_OpHash
-		case rsync.OpBlockRange:
-			opPbType = pb.Operation_OpBlockRange
+		if seq >= skip {
+			err = send(&pb.Operation{
+				Type:          rsyncOpTypeToPb(op.Type),
+				BlockIndex:    op.BlockIndex,
+				BlockIndexEnd: op.BlockIndexEnd,
+				Data:          op.Data,
+				Seq:           seq,
+			})
+			if err != nil {
+				// The stream is broken, CloseAndRecv returns why.
+				break
+			}
 		}
-
-		err = writeStream.Send(&pb.Operation{
-			ProjectId:     c.projectId,
-			ChangeId:      c.changeId,
-			PathHash:      pathToHash(filePath),
-			Type:          opPbType,
-			BlockIndex:    op.BlockIndex,
-			BlockIndexEnd: op.BlockIndexEnd,
-			Data:          op.Data,
-		})
+		seq++
+	}
+	if err == nil {
+		err = <-deltaErr
 		if err != nil {
 			return err
 		}
-		sent += 1
-	}
-	// We have to send a tombstone if we have not generated any ops (empty file)
-	if sent == 0 {
-		writeStream.Send(&pb.Operation{
-			ProjectId:     c.projectId,
-			ChangeId:      c.changeId,
-			PathHash:      pathToHash(filePath),
-			Type:          pb.Operation_OpData,
-			BlockIndex:    0,
-			BlockIndexEnd: 0,
-			Data:          []byte{},
+		// We have to send a tombstone if we have not generated any ops (empty file)
+		if seq == 0 {
+			err = send(&pb.Operation{
+				Type: pb.Operation_OpData,
+				Data: []byte{},
+			})
+			seq++
+		}
+	}
+	if err == nil {
+		err = send(&pb.Operation{
+			Type:        pb.Operation_OpHash,
+			Seq:         seq,
+			ContentHash: contentHash.Sum64(),
 		})
 	}
 	_, err = writeStream.CloseAndRecv()
 	return err
 }
 
-func (c *Client) UpdateFile(ctx context.Context, path string, sourceReader io.Reader) error {
-	err := c.CreateChange()
+func rsyncOpTypeToPb(opType rsync.OpType) pb.Operation_Type {
+	switch opType {
+	case rsync.OpBlock:
+		return pb.Operation_OpBlock
+	case rsync.OpData:
+		return pb.Operation_OpData
+	case rsync.OpHash:
+		return pb.Operation_OpHash
+	}
+	return pb.Operation_OpBlockRange
+}
+
+// CopyFile writes the content that fromPath had before the current change to toPath,
+// without uploading it. hash is the expected hash of the content.
+func (c *Client) CopyFile(ctx context.Context, fromPath string, toPath string, hash uint64) error {
+	_, err := c.api.CopyFile(ctx, &pb.CopyFileRequest{
+		ProjectId:    c.projectId,
+		ChangeId:     c.changeId,
+		FromPathHash: pathToHash(fromPath),
+		ToPathHash:   pathToHash(toPath),
+		Hash:         hash,
+	})
+	if err != nil {
+		return err
+	}
+	c.paths = append(c.paths, toPath)
+	return nil
+}
+
+// UpdateFile commits a change that replaces the content of path. The change is
+// aborted if anything fails before it is committed.
+func (c *Client) UpdateFile(ctx context.Context, path string, sourceReader io.Reader) (err error) {
+	err = c.CreateChange("")
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err != nil {
+			if abortErr := c.AbortChange(); abortErr != nil {
+				log.Println("could not abort change:", abortErr)
+			}
+		}
+	}()
 
 	data, err := io.ReadAll(sourceReader)
 	if err != nil {
@@ -250,6 +425,8 @@ func (c *Client) DiffLocalToRemote(ctx context.Context, fileMetadata *pb.FileMet
 		}
 	}
 
+	detectMoves(fileMetadataDiff, remoteFileMetadata)
+
 	return &pb.FileMetadataDiff{
 		Diffs: fileMetadataDiff,
 	}, err
@@ -300,11 +477,54 @@ func (c *Client) DiffRemoteToLocal(ctx context.Context, fileMetadata *pb.FileMet
 		}
 	}
 
+	detectMoves(fileMetadataDiff, fileMetadata)
+
 	return &pb.FileMetadataDiff{
 		Diffs: fileMetadataDiff,
 	}, err
 }
 
+// detectMoves turns each created file with the same content as a deleted file into a
+// move from the deleted file. oldFileMetadata is the file list that the deleted files
+// are in.
+func detectMoves(diffs map[string]*pb.FileMetadataDiff_FileDiff, oldFileMetadata *pb.FileMetadata) {
+	deletedPaths := make([]string, 0)
+	createdPaths := make([]string, 0)
+	for path, diff := range diffs {
+		switch diff.GetType() {
+		case pb.FileMetadataDiff_Delete:
+			if oldFile, found := oldFileMetadata.GetFiles()[path]; found && !oldFile.GetDir() && oldFile.GetSymlinkTarget() == "" {
+				deletedPaths = append(deletedPaths, path)
+			}
+		case pb.FileMetadataDiff_Create:
+			if !diff.GetFile().GetDir() && diff.GetFile().GetSymlinkTarget() == "" {
+				createdPaths = append(createdPaths, path)
+			}
+		}
+	}
+	if len(deletedPaths) == 0 || len(createdPaths) == 0 {
+		return
+	}
+
+	// Sorting pairs up files with the same content the same way every time.
+	sort.Strings(deletedPaths)
+	sort.Strings(createdPaths)
+	deletedByHash := make(map[uint64][]string)
+	for _, path := range deletedPaths {
+		hash := oldFileMetadata.GetFiles()[path].GetHash()
+		deletedByHash[hash] = append(deletedByHash[hash], path)
+	}
+	for _, path := range createdPaths {
+		candidates := deletedByHash[diffs[path].GetFile().GetHash()]
+		if len(candidates) == 0 {
+			continue
+		}
+		diffs[path].Type = pb.FileMetadataDiff_Move
+		diffs[path].FromPath = candidates[0]
+		deletedByHash[diffs[path].GetFile().GetHash()] = candidates[1:]
+	}
+}
+
 func (c *Client) DownloadFile(ctx context.Context, filePath string, localReader io.ReadSeeker, localWriter io.Writer) error {
 	rs := rsync.RSync{UniqueHasher: xxhash.New()}
 	blockHashes := make([]*pb.BlockHash, 0)
@@ -321,11 +541,12 @@ func (c *Client) DownloadFile(ctx context.Context, filePath string, localReader
 	}
 
 	readFileClient, err := c.api.ReadFile(ctx, &pb.ReadFileRequest{
-		ProjectId:   c.projectId,
-		ChangeId:    c.changeId,
-		PathHash:    pathToHash(filePath),
-		ModTime:     timestamppb.Now(),
-		BlockHashes: blockHashes,
+		ProjectId:         c.projectId,
+		ChangeId:          c.changeId,
+		PathHash:          pathToHash(filePath),
+		ModTime:           timestamppb.Now(),
+		BlockHashes:       blockHashes,
+		IncludeOpenChange: c.includeOpenChange,
 	})
 	if err != nil {
 		return err
@@ -333,19 +554,22 @@ func (c *Client) DownloadFile(ctx context.Context, filePath string, localReader
 
 	numOps := 0
 	ops := make(chan rsync.Operation)
+	// recvErr is only read once ops is closed.
+	var recvErr error
 	go func() {
+		defer close(ops)
 		for {
 			in, err := readFileClient.Recv()
 			if err == io.EOF {
-				break
+				return
 			}
 			if err != nil {
-				panic(err)
+				recvErr = err
+				return
 			}
 			ops <- rsync.PbOperationToRsync(in)
 			numOps += 1
 		}
-		close(ops)
 	}()
 	localReader.Seek(0, 0)
 	err = rs.ApplyDelta(localWriter, localReader, ops)
@@ -353,18 +577,19 @@ func (c *Client) DownloadFile(ctx context.Context, filePath string, localReader
 		return err
 	}
 
-	return err
+	return recvErr
 }
 
 func (c *Client) ProjectConfig() *pb.ProjectConfig {
 	return &pb.ProjectConfig{
 		CurrentChange: c.changeId,
 		ProjectId:     c.projectId,
+		Branch:        c.branch,
 	}
 }
 
-func (c *Client) BrowseProject(path string) (*pb.BrowseProjectResponse, error) {
-	ctx := context.Background()
+// FileList returns the metadata of every file in the project at the client's change.
+func (c *Client) FileList(ctx context.Context) (*pb.FileMetadata, error) {
 	metadataResult := new(bytes.Buffer)
 	err := c.DownloadFile(ctx, ".jamsyncfilelist", bytes.NewReader([]byte{}), metadataResult)
 	if err != nil {
@@ -375,6 +600,40 @@ func (c *Client) BrowseProject(path string) (*pb.BrowseProjectResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	return fileMetadata, nil
+}
+
+// ExportSnapshot writes an archive of every file in the project at the client's change
+// to w.
+func (c *Client) ExportSnapshot(ctx context.Context, format pb.ExportSnapshotRequest_Format, w io.Writer) error {
+	stream, err := c.api.ExportSnapshot(ctx, &pb.ExportSnapshotRequest{
+		ProjectId: c.projectId,
+		ChangeId:  c.changeId,
+		Format:    format,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(chunk.GetData())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) BrowseProject(path string) (*pb.BrowseProjectResponse, error) {
+	fileMetadata, err := c.FileList(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
 	directoryNames := make([]string, 0, len(fileMetadata.GetFiles()))
 	fileNames := make([]string, 0, len(fileMetadata.GetFiles()))
@@ -98,7 +98,7 @@ func setup() (pb.JamsyncAPIClient, func(), error) {
 				log.Fatal(err)
 			}
 		}
-		_, err := server.New()
+		_, err := server.New(server.LocalStores("jb"), server.RetentionPolicy{})
 		if err != nil && !strings.Contains(err.Error(), "bind: address already in use") {
 			return nil, nil, err
 		}
@@ -174,7 +174,7 @@ func TestClient_UploadDownload(t *testing.T) {
 
 	for _, fileOperation := range fileOperations {
 		t.Run(fileOperation.name, func(t *testing.T) {
-			err := client.CreateChange()
+			err := client.CreateChange("")
 			require.NoError(t, err)
 
 			var currData []byte
@@ -282,7 +282,7 @@ func TestClient_RandUploadDownload(t *testing.T) {
 	for _, pair := range pairs {
 		t.Run(pair.Description, func(t *testing.T) {
 			uploadFile := func(data []byte) {
-				client.CreateChange()
+				client.CreateChange("")
 				require.NoError(t, err)
 
 				err = client.UploadFile(ctx, pair.FilePath, bytes.NewReader(data))
@@ -313,7 +313,7 @@ func benchmarkUpload(b *testing.B, client *Client, projectName string, filePath
 	for n := 0; n < b.N; n++ {
 		ctx := context.Background()
 
-		err := client.CreateChange()
+		err := client.CreateChange("")
 		require.NoError(b, err)
 
 		err = client.UploadFile(ctx, filePath+fmt.Sprint(rand.Int()), bytes.NewReader(content.Data))
@@ -578,7 +578,7 @@ func TestGetFileListDiff(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.args.client.CreateChange()
+			err := tt.args.client.CreateChange("")
 			require.NoError(t, err)
 
 			got, err := client.DiffLocalToRemote(tt.args.ctx, tt.args.fileMetadata)
@@ -590,7 +590,7 @@ func TestGetFileListDiff(t *testing.T) {
 				t.Errorf("GetFileListDiff() = %v, want %v", got, tt.want)
 			}
 
-			err = client.CreateChange()
+			err = client.CreateChange("")
 			require.NoError(t, err)
 
 			metadataBytes, err := proto.Marshal(tt.args.fileMetadata)
@@ -604,3 +604,36 @@ func TestGetFileListDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectMoves(t *testing.T) {
+	oldFileMetadata := &pb.FileMetadata{
+		Files: map[string]*pb.File{
+			"src/a.txt": {Hash: 1},
+			"src/b.txt": {Hash: 2},
+			"src":       {Dir: true},
+		},
+	}
+	diffs := map[string]*pb.FileMetadataDiff_FileDiff{
+		"src/a.txt":     {Type: pb.FileMetadataDiff_Delete},
+		"src/b.txt":     {Type: pb.FileMetadataDiff_Delete},
+		"src":           {Type: pb.FileMetadataDiff_Delete},
+		"lib/a.txt":     {Type: pb.FileMetadataDiff_Create, File: &pb.File{Hash: 1}},
+		"lib/copy.txt":  {Type: pb.FileMetadataDiff_Create, File: &pb.File{Hash: 1}},
+		"lib/c.txt":     {Type: pb.FileMetadataDiff_Create, File: &pb.File{Hash: 3}},
+		"lib":           {Type: pb.FileMetadataDiff_Create, File: &pb.File{Dir: true}},
+		"unchanged.txt": {Type: pb.FileMetadataDiff_NoOp},
+		"lib/b_new.txt": {Type: pb.FileMetadataDiff_Create, File: &pb.File{Hash: 2}},
+	}
+
+	detectMoves(diffs, oldFileMetadata)
+
+	require.Equal(t, pb.FileMetadataDiff_Move, diffs["lib/a.txt"].GetType())
+	require.Equal(t, "src/a.txt", diffs["lib/a.txt"].GetFromPath())
+	require.Equal(t, pb.FileMetadataDiff_Move, diffs["lib/b_new.txt"].GetType())
+	require.Equal(t, "src/b.txt", diffs["lib/b_new.txt"].GetFromPath())
+	// Each deleted file is the source of at most one move.
+	require.Equal(t, pb.FileMetadataDiff_Create, diffs["lib/copy.txt"].GetType())
+	require.Equal(t, pb.FileMetadataDiff_Create, diffs["lib/c.txt"].GetType())
+	require.Equal(t, pb.FileMetadataDiff_Create, diffs["lib"].GetType())
+	require.Equal(t, pb.FileMetadataDiff_Delete, diffs["src/a.txt"].GetType())
+}
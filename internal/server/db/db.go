@@ -3,7 +3,7 @@ package db
 import (
 	"database/sql"
 	"errors"
-	"fmt"
+	"strings"
 )
 
 type JamsyncDb struct {
@@ -11,33 +11,86 @@ type JamsyncDb struct {
 }
 
 func New() (jamsyncDB JamsyncDb) {
-	var db *sql.DB
 	conn, err := sql.Open("sqlite3", "./jamsync.db")
 	if err != nil {
 		panic(err)
 	}
-	db = conn
+	return setup(conn)
+}
+
+// NewMemory returns a database that is only kept in memory.
+func NewMemory() (jamsyncDB JamsyncDb) {
+	conn, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		panic(err)
+	}
+	// Every connection to :memory: gets its own empty database.
+	conn.SetMaxOpenConns(1)
+	return setup(conn)
+}
 
+func setup(db *sql.DB) JamsyncDb {
 	sqlStmt := `
 	CREATE TABLE IF NOT EXISTS users (username TEXT, user_id TEXT, UNIQUE(username, user_id));
 	CREATE TABLE IF NOT EXISTS projects (name TEXT, owner TEXT);
+	CREATE TABLE IF NOT EXISTS project_members (project_id INTEGER, user_id TEXT, role INTEGER, UNIQUE(project_id, user_id));
+	CREATE TABLE IF NOT EXISTS project_ignores (project_id INTEGER PRIMARY KEY, patterns TEXT);
 	`
-	_, err = db.Exec(sqlStmt)
+	_, err := db.Exec(sqlStmt)
 	if err != nil {
 		panic(err)
 	}
+
+	// Databases created before projects could be archived are missing the column.
+	var archivedColumns int
+	err = db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('projects') WHERE name = 'archived'").Scan(&archivedColumns)
+	if err != nil {
+		panic(err)
+	}
+	if archivedColumns == 0 {
+		_, err = db.Exec("ALTER TABLE projects ADD COLUMN archived INTEGER NOT NULL DEFAULT 0")
+		if err != nil {
+			panic(err)
+		}
+	}
 	return JamsyncDb{db}
 }
 
+// ErrProjectExists is returned when an owner already has a project with a name.
+var ErrProjectExists = errors.New("project already exists")
+
 type Project struct {
-	Name string
-	Id   uint64
+	Name     string
+	Id       uint64
+	Archived bool
+}
+
+// Role is the level of access a user has to a project. Roles are ordered so that
+// a higher role includes every permission of the roles below it.
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleReader
+	RoleWriter
+	RoleOwner
+)
+
+type ProjectMember struct {
+	UserId   string
+	Username string
+	Role     Role
 }
 
 func (j JamsyncDb) AddProject(projectName string, owner string) (uint64, error) {
-	_, err := j.GetProjectId(projectName, owner)
+	row := j.db.QueryRow("SELECT rowid FROM projects WHERE name = ? AND owner = ?", projectName, owner)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+	var existingId uint64
+	err := row.Scan(&existingId)
 	if !errors.Is(sql.ErrNoRows, err) {
-		return 0, fmt.Errorf("project already exists")
+		return 0, ErrProjectExists
 	}
 
 	res, err := j.db.Exec("INSERT INTO projects(name, owner) VALUES(?, ?)", projectName, owner)
@@ -53,6 +106,80 @@ func (j JamsyncDb) AddProject(projectName string, owner string) (uint64, error)
 	return uint64(id), nil
 }
 
+// RenameProject renames a project, failing if its owner has another project with the
+// same name.
+func (j JamsyncDb) RenameProject(projectId uint64, projectName string) error {
+	var existing int
+	err := j.db.QueryRow("SELECT COUNT(*) FROM projects WHERE name = ? AND owner = (SELECT owner FROM projects WHERE rowid = ?) AND rowid != ?",
+		projectName, projectId, projectId).Scan(&existing)
+	if err != nil {
+		return err
+	}
+	if existing > 0 {
+		return ErrProjectExists
+	}
+
+	res, err := j.db.Exec("UPDATE projects SET name = ? WHERE rowid = ?", projectName, projectId)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+// SetProjectArchived archives or unarchives a project.
+func (j JamsyncDb) SetProjectArchived(projectId uint64, archived bool) error {
+	res, err := j.db.Exec("UPDATE projects SET archived = ? WHERE rowid = ?", archived, projectId)
+	if err != nil {
+		return err
+	}
+	return requireAffected(res)
+}
+
+func (j JamsyncDb) IsProjectArchived(projectId uint64) (bool, error) {
+	var archived bool
+	err := j.db.QueryRow("SELECT archived FROM projects WHERE rowid = ?", projectId).Scan(&archived)
+	return archived, err
+}
+
+// DeleteProject removes a project along with its members and settings.
+func (j JamsyncDb) DeleteProject(projectId uint64) error {
+	tx, err := j.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("DELETE FROM projects WHERE rowid = ?", projectId)
+	if err != nil {
+		return err
+	}
+	err = requireAffected(res)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM project_members WHERE project_id = ?", projectId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("DELETE FROM project_ignores WHERE project_id = ?", projectId)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// requireAffected returns sql.ErrNoRows if a statement changed no rows.
+func requireAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (j JamsyncDb) GetProjectOwner(projectId uint64) (string, error) {
 	row := j.db.QueryRow("SELECT owner FROM projects WHERE rowid = ?", projectId)
 	if row.Err() != nil {
@@ -64,8 +191,12 @@ func (j JamsyncDb) GetProjectOwner(projectId uint64) (string, error) {
 	return owner, err
 }
 
-func (j JamsyncDb) GetProjectId(projectName string, owner string) (uint64, error) {
-	row := j.db.QueryRow("SELECT rowid FROM projects WHERE name = ? AND owner = ?", projectName, owner)
+// GetProjectId looks up a project by name among the projects userId owns or is a
+// member of. Projects owned by userId take precedence over shared ones.
+func (j JamsyncDb) GetProjectId(projectName string, userId string) (uint64, error) {
+	row := j.db.QueryRow(`SELECT rowid FROM projects
+		WHERE name = ? AND (owner = ? OR rowid IN (SELECT project_id FROM project_members WHERE user_id = ?))
+		ORDER BY owner = ? DESC LIMIT 1`, projectName, userId, userId, userId)
 	if row.Err() != nil {
 		return 0, row.Err()
 	}
@@ -75,8 +206,67 @@ func (j JamsyncDb) GetProjectId(projectName string, owner string) (uint64, error
 	return id, err
 }
 
-func (j JamsyncDb) ListUserProjects(owner string) ([]Project, error) {
-	rows, err := j.db.Query("SELECT rowid, name FROM projects WHERE owner = ?", owner)
+func (j JamsyncDb) GetProjectRole(projectId uint64, userId string) (Role, error) {
+	owner, err := j.GetProjectOwner(projectId)
+	if err != nil {
+		return RoleNone, err
+	}
+	if owner == userId {
+		return RoleOwner, nil
+	}
+
+	row := j.db.QueryRow("SELECT role FROM project_members WHERE project_id = ? AND user_id = ?", projectId, userId)
+	if row.Err() != nil {
+		return RoleNone, row.Err()
+	}
+
+	var role Role
+	err = row.Scan(&role)
+	if errors.Is(sql.ErrNoRows, err) {
+		return RoleNone, nil
+	}
+	return role, err
+}
+
+func (j JamsyncDb) AddProjectMember(projectId uint64, userId string, role Role) error {
+	_, err := j.db.Exec("INSERT OR REPLACE INTO project_members(project_id, user_id, role) VALUES (?, ?, ?)", projectId, userId, role)
+	return err
+}
+
+func (j JamsyncDb) RemoveProjectMember(projectId uint64, userId string) error {
+	_, err := j.db.Exec("DELETE FROM project_members WHERE project_id = ? AND user_id = ?", projectId, userId)
+	return err
+}
+
+// ListProjectMembers returns the owner of a project followed by every other member.
+func (j JamsyncDb) ListProjectMembers(projectId uint64) ([]ProjectMember, error) {
+	rows, err := j.db.Query(`SELECT p.owner, IFNULL(u.username, ''), ? FROM projects AS p LEFT JOIN users AS u ON u.user_id = p.owner WHERE p.rowid = ?
+		UNION ALL
+		SELECT m.user_id, IFNULL(u.username, ''), m.role FROM project_members AS m LEFT JOIN users AS u ON u.user_id = m.user_id WHERE m.project_id = ?`,
+		RoleOwner, projectId, projectId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := make([]ProjectMember, 0)
+	for rows.Next() {
+		m := ProjectMember{}
+		err = rows.Scan(&m.UserId, &m.Username, &m.Role)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, m)
+	}
+	return data, err
+}
+
+// ListUserProjects returns the projects userId owns or is a member of. Archived
+// projects are left out unless includeArchived is set.
+func (j JamsyncDb) ListUserProjects(userId string, includeArchived bool) ([]Project, error) {
+	rows, err := j.db.Query(`SELECT rowid, name, archived FROM projects
+		WHERE (owner = ? OR rowid IN (SELECT project_id FROM project_members WHERE user_id = ?)) AND (? OR NOT archived)`,
+		userId, userId, includeArchived)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +275,7 @@ func (j JamsyncDb) ListUserProjects(owner string) ([]Project, error) {
 	data := make([]Project, 0)
 	for rows.Next() {
 		u := Project{}
-		err = rows.Scan(&u.Id, &u.Name)
+		err = rows.Scan(&u.Id, &u.Name, &u.Archived)
 		if err != nil {
 			return nil, err
 		}
@@ -113,6 +303,61 @@ func (j JamsyncDb) ListProjects() ([]Project, error) {
 	return data, err
 }
 
+// ListAllProjects returns every project of every user.
+func (j JamsyncDb) ListAllProjects() ([]Project, error) {
+	rows, err := j.db.Query("SELECT rowid, name FROM projects")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	data := make([]Project, 0)
+	for rows.Next() {
+		u := Project{}
+		err = rows.Scan(&u.Id, &u.Name)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, u)
+	}
+	return data, rows.Err()
+}
+
+// GetProjectIgnorePatterns returns the ignore patterns stored for a project, or
+// sql.ErrNoRows if none have been set.
+func (j JamsyncDb) GetProjectIgnorePatterns(projectId uint64) ([]string, error) {
+	row := j.db.QueryRow("SELECT patterns FROM project_ignores WHERE project_id = ?", projectId)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var patterns string
+	err := row.Scan(&patterns)
+	if err != nil {
+		return nil, err
+	}
+	if patterns == "" {
+		return []string{}, nil
+	}
+	return strings.Split(patterns, "\n"), nil
+}
+
+func (j JamsyncDb) SetProjectIgnorePatterns(projectId uint64, patterns []string) error {
+	_, err := j.db.Exec("INSERT OR REPLACE INTO project_ignores(project_id, patterns) VALUES (?, ?)", projectId, strings.Join(patterns, "\n"))
+	return err
+}
+
+func (j JamsyncDb) GetUserId(username string) (string, error) {
+	row := j.db.QueryRow("SELECT user_id FROM users WHERE username = ?", username)
+	if row.Err() != nil {
+		return "", row.Err()
+	}
+
+	var userId string
+	err := row.Scan(&userId)
+	return userId, err
+}
+
 func (j JamsyncDb) CreateUser(username, userId string) error {
 	_, err := j.db.Exec("INSERT OR IGNORE INTO users(username, user_id) VALUES (?, ?)", username, userId)
 	return err
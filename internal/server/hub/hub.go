@@ -41,7 +41,7 @@ func (hub *Hub) Run() {
 			}
 		case message := <-hub.broadcast:
 			for client := range hub.clients {
-				if client.projectId == message.ProjectId && client.userId == message.UserId {
+				if client.projectId == message.ProjectId {
 					client.Send <- message
 				}
 			}
@@ -0,0 +1,68 @@
+package oplocstore
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MemoryOpLocStore is an OpLocStore that keeps everything in memory.
+type MemoryOpLocStore struct {
+	mu     *sync.Mutex
+	opLocs map[string]*pb.OperationLocations
+}
+
+func NewMemoryOpLocStore() MemoryOpLocStore {
+	return MemoryOpLocStore{
+		mu:     &sync.Mutex{},
+		opLocs: make(map[string]*pb.OperationLocations),
+	}
+}
+
+func memoryKey(projectId uint64, ownerId string, changeId uint64, pathHash uint64) string {
+	return fmt.Sprintf("%s/%d/%d/%d", ownerId, projectId, changeId, pathHash)
+}
+
+func (s MemoryOpLocStore) InsertOperationLocations(opLocs *pb.OperationLocations) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoryKey(opLocs.GetProjectId(), opLocs.GetOwnerId(), opLocs.GetChangeId(), opLocs.GetPathHash())
+	s.opLocs[key] = proto.Clone(opLocs).(*pb.OperationLocations)
+	return nil
+}
+
+func (s MemoryOpLocStore) ListOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (opLocs *pb.OperationLocations, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, found := s.opLocs[memoryKey(projectId, ownerId, changeId, pathHash)]
+	if !found {
+		return nil, nil
+	}
+	return proto.Clone(stored).(*pb.OperationLocations), nil
+}
+
+func (s MemoryOpLocStore) DeleteOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.opLocs, memoryKey(projectId, ownerId, changeId, pathHash))
+	return nil
+}
+
+func (s MemoryOpLocStore) DeleteProject(projectId uint64, ownerId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := fmt.Sprintf("%s/%d/", ownerId, projectId)
+	for key := range s.opLocs {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.opLocs, key)
+		}
+	}
+	return nil
+}
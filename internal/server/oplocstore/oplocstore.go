@@ -1,16 +1,23 @@
 package oplocstore
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"io"
 	"os"
 
 	"github.com/zdgeier/jamsync/gen/pb"
 	"google.golang.org/protobuf/proto"
 )
 
+// OpLocStore stores where the operations of each file and change are in an OpStore.
+// ListOperationLocations returns nil when a file has no operations in a change.
+type OpLocStore interface {
+	InsertOperationLocations(opLocs *pb.OperationLocations) error
+	ListOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (opLocs *pb.OperationLocations, err error)
+	DeleteOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error
+	DeleteProject(projectId uint64, ownerId string) error
+}
+
 type LocalOpLocStore struct {
 	directory string
 }
@@ -33,38 +40,47 @@ func (s LocalOpLocStore) InsertOperationLocations(opLocs *pb.OperationLocations)
 	if err != nil {
 		return err
 	}
-	filePath := s.filePath(opLocs.GetProjectId(), opLocs.GetOwnerId(), opLocs.GetChangeId(), opLocs.GetPathHash())
-	currFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0644)
+	bytes, err := proto.Marshal(opLocs)
 	if err != nil {
 		return err
 	}
-	bytes, err := proto.Marshal(opLocs)
+	// Locations are replaced when a file is uploaded again in the same change or
+	// compacted. Renaming a new file into place means readers see either the old or
+	// the new locations, never a mix.
+	tmpFile, err := os.CreateTemp(s.opLocDirectory(opLocs.GetProjectId(), opLocs.GetOwnerId(), opLocs.GetChangeId()), "*.tmp")
 	if err != nil {
 		return err
 	}
-	_, err = currFile.Write(bytes)
-	currFile.Close()
-	return err
+	_, err = tmpFile.Write(bytes)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), s.filePath(opLocs.GetProjectId(), opLocs.GetOwnerId(), opLocs.GetChangeId(), opLocs.GetPathHash()))
 }
 func (s LocalOpLocStore) ListOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (opLocs *pb.OperationLocations, err error) {
-	filePath := s.filePath(projectId, ownerId, changeId, pathHash)
-
-	_, err = os.Stat(filePath)
-	if err != nil && errors.Is(err, os.ErrNotExist) {
+	data, err := os.ReadFile(s.filePath(projectId, ownerId, changeId, pathHash))
+	if errors.Is(err, os.ErrNotExist) {
 		return nil, nil
 	}
-	currFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0644)
-	if err != nil {
-		return nil, err
-	}
-	buf := bytes.NewBuffer(nil)
-	currFile.Seek(0, 0)
-	_, err = io.Copy(buf, currFile)
 	if err != nil {
 		return nil, err
 	}
 
 	opLocs = &pb.OperationLocations{}
-	err = proto.Unmarshal(buf.Bytes(), opLocs)
+	err = proto.Unmarshal(data, opLocs)
 	return opLocs, err
 }
+func (s LocalOpLocStore) DeleteOperationLocations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	err := os.Remove(s.filePath(projectId, ownerId, changeId, pathHash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+func (s LocalOpLocStore) DeleteProject(projectId uint64, ownerId string) error {
+	return os.RemoveAll(fmt.Sprintf("%s/%s/%d/oplocs", s.directory, ownerId, projectId))
+}
@@ -89,6 +89,12 @@ func TestOpLocStore(t *testing.T) {
 			got, err = m.ListOperationLocations(tt.args.opLocs.GetProjectId(), tt.args.opLocs.GetOwnerId(), tt.args.opLocs.GetPathHash(), tt.args.opLocs.GetChangeId())
 			require.NoError(t, err)
 			require.True(t, proto.Equal(tt.want, got))
+
+			require.NoError(t, m.DeleteOperationLocations(tt.args.opLocs.GetProjectId(), tt.args.opLocs.GetOwnerId(), tt.args.opLocs.GetPathHash(), tt.args.opLocs.GetChangeId()))
+			require.NoError(t, m.DeleteOperationLocations(tt.args.opLocs.GetProjectId(), tt.args.opLocs.GetOwnerId(), tt.args.opLocs.GetPathHash(), tt.args.opLocs.GetChangeId()))
+			got, err = m.ListOperationLocations(tt.args.opLocs.GetProjectId(), tt.args.opLocs.GetOwnerId(), tt.args.opLocs.GetPathHash(), tt.args.opLocs.GetChangeId())
+			require.NoError(t, err)
+			require.Nil(t, got)
 		})
 	}
 	err := os.RemoveAll("jb")
@@ -96,3 +102,37 @@ func TestOpLocStore(t *testing.T) {
 		log.Fatal(err)
 	}
 }
+
+func TestMemoryOpLocStore(t *testing.T) {
+	opLocs := &pb.OperationLocations{
+		ProjectId: 3,
+		OwnerId:   "testowner",
+		ChangeId:  4,
+		PathHash:  123,
+		OpLocs: []*pb.OperationLocations_OperationLocation{
+			{
+				Offset: 10,
+				Length: 20,
+			},
+		},
+	}
+
+	s := NewMemoryOpLocStore()
+	got, err := s.ListOperationLocations(3, "testowner", 123, 4)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, s.InsertOperationLocations(opLocs))
+	got, err = s.ListOperationLocations(3, "testowner", 123, 4)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(opLocs, got))
+
+	got, err = s.ListOperationLocations(3, "testowner", 123, 5)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	require.NoError(t, s.DeleteOperationLocations(3, "testowner", 123, 4))
+	got, err = s.ListOperationLocations(3, "testowner", 123, 4)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,105 @@
+package opstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func (s LocalStore) checkpointDirectory(projectId uint64, ownerId string, pathHash uint64) string {
+	return fmt.Sprintf("%s/%s/%d/checkpoints/%d", s.directory, ownerId, projectId, pathHash)
+}
+func (s LocalStore) checkpointPath(projectId uint64, ownerId string, pathHash uint64, changeId uint64) string {
+	return fmt.Sprintf("%s/%d.jb", s.checkpointDirectory(projectId, ownerId, pathHash), changeId)
+}
+
+// WriteCheckpoint stores the fully materialized contents of a file as of changeId so
+// that regenerating the file later can start from here instead of the first change.
+func (s LocalStore) WriteCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64, data []byte) error {
+	err := os.MkdirAll(s.checkpointDirectory(projectId, ownerId, pathHash), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	// Concurrent writers of the same checkpoint each write their own temporary file.
+	tmpFile, err := os.CreateTemp(s.checkpointDirectory(projectId, ownerId, pathHash), "*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(data)
+	if err == nil && s.options.SyncPolicy != SyncNever {
+		err = tmpFile.Sync()
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), s.checkpointPath(projectId, ownerId, pathHash, changeId))
+}
+
+// ReadCheckpoint returns the contents of the closest checkpoint at or below changeId.
+// A returned checkpointChangeId of zero means there is no usable checkpoint.
+func (s LocalStore) ReadCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (checkpointChangeId uint64, data []byte, err error) {
+	checkpointIds, err := s.listCheckpoints(projectId, ownerId, pathHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, id := range checkpointIds {
+		if id <= changeId && id > checkpointChangeId {
+			checkpointChangeId = id
+		}
+	}
+	if checkpointChangeId == 0 {
+		return 0, nil, nil
+	}
+	data, err = os.ReadFile(s.checkpointPath(projectId, ownerId, pathHash, checkpointChangeId))
+	if err != nil {
+		return 0, nil, err
+	}
+	return checkpointChangeId, data, nil
+}
+
+// DeleteCheckpoints removes every checkpoint at or above changeId. It should be called
+// whenever new operations are written for a change that a checkpoint may already cover.
+func (s LocalStore) DeleteCheckpoints(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	checkpointIds, err := s.listCheckpoints(projectId, ownerId, pathHash)
+	if err != nil {
+		return err
+	}
+	for _, id := range checkpointIds {
+		if id >= changeId {
+			err := os.Remove(s.checkpointPath(projectId, ownerId, pathHash, id))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s LocalStore) listCheckpoints(projectId uint64, ownerId string, pathHash uint64) ([]uint64, error) {
+	entries, err := os.ReadDir(s.checkpointDirectory(projectId, ownerId, pathHash))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointIds := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".jb") {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(entry.Name(), ".jb"), 10, 64)
+		if err != nil {
+			continue
+		}
+		checkpointIds = append(checkpointIds, id)
+	}
+	return checkpointIds, nil
+}
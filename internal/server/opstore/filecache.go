@@ -0,0 +1,186 @@
+package opstore
+
+import (
+	"container/list"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// opFile is an open operation data file. Its mutex serializes writers so that the
+// offset handed out for a write is always where the data ends up.
+type opFile struct {
+	path string
+	file *os.File
+
+	mu       sync.Mutex
+	size     int64
+	lastSync time.Time
+
+	// refs, element and removed are guarded by the fileCache mutex.
+	refs    int
+	element *list.Element
+	removed bool
+}
+
+// fileCache keeps up to maxOpen operation data files open, closing the least recently
+// used file that is not in use when a new one is needed. Files in use are never
+// closed, so the cap can be exceeded briefly under heavy concurrency.
+type fileCache struct {
+	mu          sync.Mutex
+	maxOpen     int
+	syncOnClose bool
+	files       map[string]*opFile
+	lru         *list.List
+}
+
+func newFileCache(maxOpen int, syncOnClose bool) *fileCache {
+	return &fileCache{
+		maxOpen:     maxOpen,
+		syncOnClose: syncOnClose,
+		files:       make(map[string]*opFile),
+		lru:         list.New(),
+	}
+}
+
+// acquire returns the open file at path, opening it if needed. The file is created if
+// it does not exist and create is set. Every call must be paired with a call to
+// release.
+func (c *fileCache) acquire(path string, create bool) (*opFile, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if f, found := c.files[path]; found {
+		f.refs++
+		c.lru.MoveToFront(f.element)
+		return f, nil
+	}
+
+	flag := os.O_APPEND | os.O_RDWR
+	if create {
+		flag |= os.O_CREATE
+	}
+	file, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	f := &opFile{
+		path:     path,
+		file:     file,
+		size:     info.Size(),
+		lastSync: time.Now(),
+		refs:     1,
+	}
+	f.element = c.lru.PushFront(f)
+	c.files[path] = f
+	c.evict()
+	return f, nil
+}
+
+func (c *fileCache) release(f *opFile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f.refs--
+	if f.removed {
+		if f.refs == 0 {
+			f.file.Close()
+		}
+		return
+	}
+	c.evict()
+}
+
+// remove deletes the file at path. If it is open, it is dropped from the cache and
+// closed once the last user releases it.
+func (c *fileCache) remove(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if f, found := c.files[path]; found {
+		c.lru.Remove(f.element)
+		delete(c.files, path)
+		f.removed = true
+		if f.refs == 0 {
+			f.file.Close()
+		}
+	}
+	err := os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// evict closes unused files, oldest first, until the cache is within its cap.
+func (c *fileCache) evict() {
+	for e := c.lru.Back(); e != nil && c.lru.Len() > c.maxOpen; {
+		f := e.Value.(*opFile)
+		prev := e.Prev()
+		if f.refs == 0 {
+			c.lru.Remove(e)
+			delete(c.files, f.path)
+			if c.syncOnClose {
+				f.file.Sync()
+			}
+			f.file.Close()
+		}
+		e = prev
+	}
+}
+
+// removeAll drops every file below prefix from the cache, closing each once it is no
+// longer in use. The files themselves are left for the caller to delete.
+func (c *fileCache) removeAll(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for path, f := range c.files {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		c.lru.Remove(f.element)
+		delete(c.files, path)
+		f.removed = true
+		if f.refs == 0 {
+			f.file.Close()
+		}
+	}
+}
+
+// close syncs and closes every open file. The cache must not be in use.
+func (c *fileCache) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var firstErr error
+	for path, f := range c.files {
+		err := f.file.Sync()
+		if err == nil {
+			err = f.file.Close()
+		} else {
+			f.file.Close()
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(c.files, path)
+	}
+	c.lru.Init()
+	return firstErr
+}
+
+// len returns the number of open files.
+func (c *fileCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
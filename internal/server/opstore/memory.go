@@ -0,0 +1,117 @@
+package opstore
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// MemoryStore is an OpStore that keeps everything in memory.
+type MemoryStore struct {
+	mu          *sync.Mutex
+	files       map[string][]byte
+	checkpoints map[string]map[uint64][]byte
+}
+
+func NewMemoryStore() MemoryStore {
+	return MemoryStore{
+		mu:          &sync.Mutex{},
+		files:       make(map[string][]byte),
+		checkpoints: make(map[string]map[uint64][]byte),
+	}
+}
+
+func memoryKey(projectId uint64, ownerId string, pathHash uint64) string {
+	return fmt.Sprintf("%s/%d/%d", ownerId, projectId, pathHash)
+}
+
+func (s MemoryStore) Read(projectId uint64, ownerId string, changeId uint64, pathHash uint64, offset uint64, length uint64) (data []byte, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file := s.files[memoryKey(projectId, ownerId, pathHash)]
+	if offset+length > uint64(len(file)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, length)
+	copy(b, file[offset:offset+length])
+	return b, nil
+}
+
+func (s MemoryStore) Write(projectId uint64, ownerId string, changeId uint64, pathHash uint64, data []byte) (offset uint64, length uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoryKey(projectId, ownerId, pathHash)
+	offset = uint64(len(s.files[key]))
+	s.files[key] = append(s.files[key], data...)
+	return offset, uint64(len(data)), nil
+}
+
+func (s MemoryStore) DeleteOperations(projectId uint64, ownerId string, pathHash uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.files, memoryKey(projectId, ownerId, pathHash))
+	return nil
+}
+
+func (s MemoryStore) DeleteProject(projectId uint64, ownerId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := fmt.Sprintf("%s/%d/", ownerId, projectId)
+	for key := range s.files {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.files, key)
+		}
+	}
+	for key := range s.checkpoints {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.checkpoints, key)
+		}
+	}
+	return nil
+}
+
+func (s MemoryStore) WriteCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoryKey(projectId, ownerId, pathHash)
+	if s.checkpoints[key] == nil {
+		s.checkpoints[key] = make(map[uint64][]byte)
+	}
+	s.checkpoints[key][changeId] = append([]byte{}, data...)
+	return nil
+}
+
+func (s MemoryStore) ReadCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (checkpointChangeId uint64, data []byte, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints := s.checkpoints[memoryKey(projectId, ownerId, pathHash)]
+	for id := range checkpoints {
+		if id <= changeId && id > checkpointChangeId {
+			checkpointChangeId = id
+		}
+	}
+	if checkpointChangeId == 0 {
+		return 0, nil, nil
+	}
+	return checkpointChangeId, append([]byte{}, checkpoints[checkpointChangeId]...), nil
+}
+
+func (s MemoryStore) DeleteCheckpoints(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints := s.checkpoints[memoryKey(projectId, ownerId, pathHash)]
+	for id := range checkpoints {
+		if id >= changeId {
+			delete(checkpoints, id)
+		}
+	}
+	return nil
+}
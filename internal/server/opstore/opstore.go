@@ -3,17 +3,73 @@ package opstore
 import (
 	"fmt"
 	"os"
+	"time"
 )
 
+// OpStore stores the operations written for each file of a project, along with
+// checkpoints of materialized file contents. The pathHash of Read, Write and
+// DeleteOperations is the key that operations are grouped under, which does not have
+// to be the hash of a single path.
+type OpStore interface {
+	Read(projectId uint64, ownerId string, changeId uint64, pathHash uint64, offset uint64, length uint64) (data []byte, err error)
+	Write(projectId uint64, ownerId string, changeId uint64, pathHash uint64, data []byte) (offset uint64, length uint64, err error)
+	// DeleteOperations removes every operation written under pathHash.
+	DeleteOperations(projectId uint64, ownerId string, pathHash uint64) error
+	// DeleteProject removes every operation and checkpoint of a project.
+	DeleteProject(projectId uint64, ownerId string) error
+	WriteCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64, data []byte) error
+	ReadCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (checkpointChangeId uint64, data []byte, err error)
+	DeleteCheckpoints(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error
+}
+
+// SyncPolicy controls when a LocalStore flushes written data to stable storage.
+type SyncPolicy int
+
+const (
+	// SyncNever leaves flushing to the operating system.
+	SyncNever SyncPolicy = iota
+	// SyncAlways flushes a file before every Write returns.
+	SyncAlways
+	// SyncInterval flushes a file on Write once LocalStoreOptions.SyncInterval has
+	// passed since it was last flushed, and when it is closed.
+	SyncInterval
+)
+
+type LocalStoreOptions struct {
+	// MaxOpenFiles caps the number of operation data files kept open.
+	MaxOpenFiles int
+	SyncPolicy   SyncPolicy
+	SyncInterval time.Duration
+}
+
+func DefaultLocalStoreOptions() LocalStoreOptions {
+	return LocalStoreOptions{
+		MaxOpenFiles: 256,
+		SyncPolicy:   SyncInterval,
+		SyncInterval: time.Second,
+	}
+}
+
+// LocalStore is an OpStore that appends the operations for each file of a project to
+// a file on disk. It is safe for concurrent use.
 type LocalStore struct {
-	directory     string
-	openFileCache map[string]*os.File
+	directory string
+	options   LocalStoreOptions
+	files     *fileCache
 }
 
 func NewLocalStore(directory string) LocalStore {
+	return NewLocalStoreWithOptions(directory, DefaultLocalStoreOptions())
+}
+
+func NewLocalStoreWithOptions(directory string, options LocalStoreOptions) LocalStore {
+	if options.MaxOpenFiles < 1 {
+		options.MaxOpenFiles = 1
+	}
 	return LocalStore{
-		directory:     directory,
-		openFileCache: make(map[string]*os.File),
+		directory: directory,
+		options:   options,
+		files:     newFileCache(options.MaxOpenFiles, options.SyncPolicy != SyncNever),
 	}
 }
 
@@ -24,17 +80,14 @@ func (s LocalStore) filePath(projectId uint64, ownerId string, changeId uint64,
 	return fmt.Sprintf("%s/%d.jb", s.changeDirectory(projectId, ownerId), pathHash)
 }
 func (s LocalStore) Read(projectId uint64, ownerId string, changeId uint64, pathHash uint64, offset uint64, length uint64) (data []byte, err error) {
-	filePath := s.filePath(projectId, ownerId, changeId, pathHash)
-	currFile, cached := s.openFileCache[filePath]
-	if !cached {
-		currFile, err = os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
-		if err != nil {
-			return nil, err
-		}
-		s.openFileCache[filePath] = currFile
+	f, err := s.files.acquire(s.filePath(projectId, ownerId, changeId, pathHash), false)
+	if err != nil {
+		return nil, err
 	}
+	defer s.files.release(f)
+
 	b := make([]byte, length)
-	_, err = currFile.ReadAt(b, int64(offset))
+	_, err = f.file.ReadAt(b, int64(offset))
 	if err != nil {
 		return nil, err
 	}
@@ -45,22 +98,46 @@ func (s LocalStore) Write(projectId uint64, ownerId string, changeId uint64, pat
 	if err != nil {
 		return 0, 0, err
 	}
-	filePath := s.filePath(projectId, ownerId, changeId, pathHash)
-	currFile, cached := s.openFileCache[filePath]
-	if !cached {
-		currFile, err = os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
-		if err != nil {
-			return 0, 0, err
-		}
-		s.openFileCache[filePath] = currFile
-	}
-	info, err := currFile.Stat()
+	f, err := s.files.acquire(s.filePath(projectId, ownerId, changeId, pathHash), true)
 	if err != nil {
 		return 0, 0, err
 	}
-	writtenBytes, err := currFile.Write(data)
+	defer s.files.release(f)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	offset = uint64(f.size)
+	writtenBytes, err := f.file.Write(data)
+	f.size += int64(writtenBytes)
 	if err != nil {
 		return 0, 0, err
 	}
-	return uint64(info.Size()), uint64(writtenBytes), nil
+
+	if s.options.SyncPolicy == SyncAlways || (s.options.SyncPolicy == SyncInterval && time.Since(f.lastSync) >= s.options.SyncInterval) {
+		err = f.file.Sync()
+		if err != nil {
+			return 0, 0, err
+		}
+		f.lastSync = time.Now()
+	}
+	return offset, uint64(writtenBytes), nil
+}
+
+func (s LocalStore) DeleteOperations(projectId uint64, ownerId string, pathHash uint64) error {
+	return s.files.remove(s.filePath(projectId, ownerId, 0, pathHash))
+}
+
+func (s LocalStore) DeleteProject(projectId uint64, ownerId string) error {
+	s.files.removeAll(s.changeDirectory(projectId, ownerId) + "/")
+	err := os.RemoveAll(s.changeDirectory(projectId, ownerId))
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(fmt.Sprintf("%s/%s/%d/checkpoints", s.directory, ownerId, projectId))
+}
+
+// Close syncs and closes the files held open by the store.
+func (s LocalStore) Close() error {
+	return s.files.close()
 }
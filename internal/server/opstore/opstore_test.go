@@ -1,13 +1,25 @@
 package opstore
 
 import (
+	"fmt"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestLocalStore(t *testing.T) {
+	testStoreReadWrite(t, NewLocalStore("jb"))
+	err := os.RemoveAll("jb")
+	require.NoError(t, err)
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStoreReadWrite(t, NewMemoryStore())
+}
+
+func testStoreReadWrite(t *testing.T, store OpStore) {
 	type writeData struct {
 		projectId uint64
 		userId    string
@@ -52,7 +64,6 @@ func TestLocalStore(t *testing.T) {
 		},
 	}
 
-	store := NewLocalStore("jb")
 	for _, test := range tests {
 		offset, length, err := store.Write(test.writeData.projectId, test.writeData.userId, test.writeData.changeId, test.writeData.pathHash, test.writeData.data)
 		require.Equal(t, writeDataResult{
@@ -66,7 +77,121 @@ func TestLocalStore(t *testing.T) {
 
 		require.Equal(t, data, test.writeData.data)
 	}
+}
 
+func TestLocalStoreCheckpoint(t *testing.T) {
+	testStoreCheckpoint(t, NewLocalStore("jb"))
 	err := os.RemoveAll("jb")
 	require.NoError(t, err)
 }
+
+func TestMemoryStoreCheckpoint(t *testing.T) {
+	testStoreCheckpoint(t, NewMemoryStore())
+}
+
+func testStoreCheckpoint(t *testing.T, store OpStore) {
+
+	changeId, data, err := store.ReadCheckpoint(1, "test", 123, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), changeId)
+	require.Nil(t, data)
+
+	require.NoError(t, store.WriteCheckpoint(1, "test", 123, 4, []byte("four")))
+	require.NoError(t, store.WriteCheckpoint(1, "test", 123, 8, []byte("eight")))
+
+	changeId, data, err = store.ReadCheckpoint(1, "test", 123, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), changeId)
+	require.Nil(t, data)
+
+	changeId, data, err = store.ReadCheckpoint(1, "test", 123, 7)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), changeId)
+	require.Equal(t, []byte("four"), data)
+
+	changeId, data, err = store.ReadCheckpoint(1, "test", 123, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), changeId)
+	require.Equal(t, []byte("eight"), data)
+
+	require.NoError(t, store.DeleteCheckpoints(1, "test", 123, 5))
+	changeId, data, err = store.ReadCheckpoint(1, "test", 123, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), changeId)
+	require.Equal(t, []byte("four"), data)
+}
+
+func TestLocalStoreDeleteOperations(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+	defer store.Close()
+	testStoreDeleteOperations(t, store)
+}
+
+func TestMemoryStoreDeleteOperations(t *testing.T) {
+	testStoreDeleteOperations(t, NewMemoryStore())
+}
+
+func testStoreDeleteOperations(t *testing.T, store OpStore) {
+	offset, length, err := store.Write(1, "test", 1, 123, []byte("deleted"))
+	require.NoError(t, err)
+	keptOffset, keptLength, err := store.Write(1, "test", 1, 456, []byte("kept"))
+	require.NoError(t, err)
+
+	require.NoError(t, store.DeleteOperations(1, "test", 123))
+	_, err = store.Read(1, "test", 1, 123, offset, length)
+	require.Error(t, err)
+	data, err := store.Read(1, "test", 1, 456, keptOffset, keptLength)
+	require.NoError(t, err)
+	require.Equal(t, []byte("kept"), data)
+
+	// Deleted operations don't take up space for new ones.
+	offset, _, err = store.Write(1, "test", 2, 123, []byte("new"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), offset)
+	require.NoError(t, store.DeleteOperations(1, "test", 789))
+}
+
+func TestLocalStoreConcurrentWrites(t *testing.T) {
+	store := NewLocalStoreWithOptions(t.TempDir(), LocalStoreOptions{MaxOpenFiles: 2, SyncPolicy: SyncNever})
+	defer store.Close()
+
+	const writers = 16
+	const writesPerWriter = 50
+	type written struct {
+		pathHash, offset, length uint64
+		data                     []byte
+	}
+	results := make(chan written, writers*writesPerWriter)
+	errs := make(chan error, writers)
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < writesPerWriter; j++ {
+				// Spread writes over more files than can be kept open.
+				pathHash := uint64(j % 5)
+				data := []byte(fmt.Sprintf("writer %d write %d", i, j))
+				offset, length, err := store.Write(1, "test", 1, pathHash, data)
+				if err != nil {
+					errs <- err
+					return
+				}
+				results <- written{pathHash, offset, length, data}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(results)
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.LessOrEqual(t, store.files.len(), 2)
+	for result := range results {
+		data, err := store.Read(1, "test", 1, result.pathHash, result.offset, result.length)
+		require.NoError(t, err)
+		require.Equal(t, result.data, data)
+	}
+}
@@ -0,0 +1,272 @@
+package opstore
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// s3WriteAttempts is how many offsets a Write tries before giving up on finding a free
+// one, which only happens when many servers write to the same change at once.
+const s3WriteAttempts = 8
+
+// S3Config configures an S3Store.
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyId     string
+	SecretAccessKey string
+	// CacheDirectory holds local copies of operation data read from the bucket. The
+	// cache is disabled when it is empty.
+	CacheDirectory string
+}
+
+// S3Store is an OpStore that keeps operation data in an S3 compatible bucket, so that
+// any number of servers can share it. Every Write is stored as its own immutable
+// object whose key includes the change and the offset returned by Write. Objects are
+// only created if their key is free, so servers writing to the same change at once
+// never overwrite each other.
+type S3Store struct {
+	client         s3Client
+	cacheDirectory string
+
+	mu      *sync.Mutex
+	offsets map[string]uint64
+}
+
+func NewS3Store(config S3Config) S3Store {
+	return S3Store{
+		client: s3Client{
+			endpoint:        config.Endpoint,
+			region:          config.Region,
+			bucket:          config.Bucket,
+			accessKeyId:     config.AccessKeyId,
+			secretAccessKey: config.SecretAccessKey,
+			httpClient:      http.DefaultClient,
+		},
+		cacheDirectory: config.CacheDirectory,
+		mu:             &sync.Mutex{},
+		offsets:        make(map[string]uint64),
+	}
+}
+
+func (s S3Store) changePrefix(projectId uint64, ownerId string, changeId uint64, pathHash uint64) string {
+	return fmt.Sprintf("%s/%d/opdata/%d/%d/", ownerId, projectId, pathHash, changeId)
+}
+
+func (s S3Store) checkpointPrefix(projectId uint64, ownerId string, pathHash uint64) string {
+	return fmt.Sprintf("%s/%d/checkpoints/%d/", ownerId, projectId, pathHash)
+}
+
+func (s S3Store) Read(projectId uint64, ownerId string, changeId uint64, pathHash uint64, offset uint64, length uint64) (data []byte, err error) {
+	key := s.changePrefix(projectId, ownerId, changeId, pathHash) + strconv.FormatUint(offset, 10)
+
+	// Objects are never modified once written, so a cached copy is always current.
+	cachePath := ""
+	if s.cacheDirectory != "" {
+		cachePath = filepath.Join(s.cacheDirectory, filepath.FromSlash(key))
+		data, err := os.ReadFile(cachePath)
+		if err == nil && uint64(len(data)) == length {
+			return data, nil
+		}
+	}
+
+	data, err = s.client.getObject(key)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != length {
+		return nil, fmt.Errorf("s3 object %s has %d bytes, expected %d", key, len(data), length)
+	}
+	if cachePath != "" {
+		s.cache(cachePath, data)
+	}
+	return data, nil
+}
+
+func (s S3Store) Write(projectId uint64, ownerId string, changeId uint64, pathHash uint64, data []byte) (offset uint64, length uint64, err error) {
+	prefix := s.changePrefix(projectId, ownerId, changeId, pathHash)
+	key := ""
+	for attempt := 1; ; attempt++ {
+		offset, err = s.reserve(prefix, uint64(len(data)))
+		if err != nil {
+			return 0, 0, err
+		}
+		key = prefix + strconv.FormatUint(offset, 10)
+		err = s.client.putNewObject(key, data)
+		if errors.Is(err, errObjectExists) && attempt < s3WriteAttempts {
+			// Another server wrote to the change since it was listed, so the offsets
+			// reserved here are stale.
+			s.forget(prefix)
+			continue
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		break
+	}
+	if s.cacheDirectory != "" {
+		s.cache(filepath.Join(s.cacheDirectory, filepath.FromSlash(key)), data)
+	}
+	return offset, uint64(len(data)), nil
+}
+
+// DeleteOperations deletes the objects of every change written under pathHash, along
+// with their cached copies.
+func (s S3Store) DeleteOperations(projectId uint64, ownerId string, pathHash uint64) error {
+	return s.deletePrefix(fmt.Sprintf("%s/%d/opdata/%d/", ownerId, projectId, pathHash))
+}
+
+// DeleteProject deletes every object of a project, along with their cached copies.
+func (s S3Store) DeleteProject(projectId uint64, ownerId string) error {
+	return s.deletePrefix(fmt.Sprintf("%s/%d/", ownerId, projectId))
+}
+
+func (s S3Store) deletePrefix(prefix string) error {
+	objects, err := s.client.listObjects(prefix)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		err = s.client.deleteObject(object.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	for reserved := range s.offsets {
+		if strings.HasPrefix(reserved, prefix) {
+			delete(s.offsets, reserved)
+		}
+	}
+	s.mu.Unlock()
+	if s.cacheDirectory != "" {
+		return os.RemoveAll(filepath.Join(s.cacheDirectory, filepath.FromSlash(prefix)))
+	}
+	return nil
+}
+
+// forget drops the offsets reserved below prefix, so that the next write continues
+// after the objects in the bucket again.
+func (s S3Store) forget(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.offsets, prefix)
+}
+
+// reserve returns the offset for the next write below prefix. The first write in a
+// process continues after the objects already in the bucket, so that a change can be
+// written to across restarts and by other servers.
+func (s S3Store) reserve(prefix string, length uint64) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	offset, found := s.offsets[prefix]
+	if !found {
+		objects, err := s.client.listObjects(prefix)
+		if err != nil {
+			return 0, err
+		}
+		for _, object := range objects {
+			objectOffset, err := strconv.ParseUint(strings.TrimPrefix(object.Key, prefix), 10, 64)
+			if err != nil {
+				continue
+			}
+			if objectOffset+object.Size > offset {
+				offset = objectOffset + object.Size
+			}
+		}
+	}
+	s.offsets[prefix] = offset + length
+	return offset, nil
+}
+
+// cache stores a local copy of an object. The cache is only an optimization, so
+// failing to write to it is not an error.
+func (s S3Store) cache(cachePath string, data []byte) {
+	err := os.MkdirAll(filepath.Dir(cachePath), os.ModePerm)
+	if err != nil {
+		return
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(cachePath), "*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmpFile.Write(data)
+	tmpFile.Close()
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return
+	}
+	os.Rename(tmpFile.Name(), cachePath)
+}
+
+func (s S3Store) WriteCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64, data []byte) error {
+	return s.client.putObject(s.checkpointPrefix(projectId, ownerId, pathHash)+strconv.FormatUint(changeId, 10), data)
+}
+
+func (s S3Store) ReadCheckpoint(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (checkpointChangeId uint64, data []byte, err error) {
+	checkpointIds, err := s.listCheckpoints(projectId, ownerId, pathHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, id := range checkpointIds {
+		if id <= changeId && id > checkpointChangeId {
+			checkpointChangeId = id
+		}
+	}
+	if checkpointChangeId == 0 {
+		return 0, nil, nil
+	}
+
+	data, err = s.client.getObject(s.checkpointPrefix(projectId, ownerId, pathHash) + strconv.FormatUint(checkpointChangeId, 10))
+	if errors.Is(err, os.ErrNotExist) {
+		// Deleted by another server since it was listed.
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return checkpointChangeId, data, nil
+}
+
+func (s S3Store) DeleteCheckpoints(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	checkpointIds, err := s.listCheckpoints(projectId, ownerId, pathHash)
+	if err != nil {
+		return err
+	}
+	for _, id := range checkpointIds {
+		if id >= changeId {
+			err := s.client.deleteObject(s.checkpointPrefix(projectId, ownerId, pathHash) + strconv.FormatUint(id, 10))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s S3Store) listCheckpoints(projectId uint64, ownerId string, pathHash uint64) ([]uint64, error) {
+	prefix := s.checkpointPrefix(projectId, ownerId, pathHash)
+	objects, err := s.client.listObjects(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointIds := make([]uint64, 0, len(objects))
+	for _, object := range objects {
+		id, err := strconv.ParseUint(strings.TrimPrefix(object.Key, prefix), 10, 64)
+		if err != nil {
+			continue
+		}
+		checkpointIds = append(checkpointIds, id)
+	}
+	return checkpointIds, nil
+}
@@ -0,0 +1,152 @@
+package opstore
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeS3 serves a single bucket from memory, supporting just the requests that
+// s3Client makes.
+func newFakeS3(t *testing.T, bucket string) *httptest.Server {
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+bucket), "/")
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			result := listBucketResult{}
+			for k, v := range objects {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+					result.Contents = append(result.Contents, s3Object{Key: k, Size: uint64(len(v))})
+				}
+			}
+			sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+			xml.NewEncoder(w).Encode(result)
+		case r.Method == http.MethodGet:
+			data, found := objects[key]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(data)
+		case r.Method == http.MethodPut:
+			if _, found := objects[key]; found && r.Header.Get("If-None-Match") == "*" {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			objects[key] = data
+		case r.Method == http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestS3Store(t *testing.T, endpoint string, cacheDirectory string) S3Store {
+	return NewS3Store(S3Config{
+		Endpoint:        endpoint,
+		Region:          "us-east-1",
+		Bucket:          "jamsync",
+		AccessKeyId:     "test",
+		SecretAccessKey: "test",
+		CacheDirectory:  cacheDirectory,
+	})
+}
+
+func TestS3Store(t *testing.T) {
+	server := newFakeS3(t, "jamsync")
+	testStoreReadWrite(t, newTestS3Store(t, server.URL, t.TempDir()))
+}
+
+func TestS3StoreCheckpoint(t *testing.T) {
+	server := newFakeS3(t, "jamsync")
+	testStoreCheckpoint(t, newTestS3Store(t, server.URL, ""))
+}
+
+func TestS3StoreDeleteOperations(t *testing.T) {
+	server := newFakeS3(t, "jamsync")
+	testStoreDeleteOperations(t, newTestS3Store(t, server.URL, t.TempDir()))
+}
+
+func TestS3StoreShared(t *testing.T) {
+	server := newFakeS3(t, "jamsync")
+	first := newTestS3Store(t, server.URL, t.TempDir())
+	second := newTestS3Store(t, server.URL, "")
+
+	offset, length, err := first.Write(1, "test", 1, 123, []byte("first"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), offset)
+
+	// A store that has not seen the change continues after what is in the bucket.
+	secondOffset, _, err := second.Write(1, "test", 1, 123, []byte("second"))
+	require.NoError(t, err)
+	require.Equal(t, length, secondOffset)
+
+	data, err := second.Read(1, "test", 1, 123, offset, length)
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), data)
+}
+
+func TestS3StoreConcurrentServers(t *testing.T) {
+	server := newFakeS3(t, "jamsync")
+	first := newTestS3Store(t, server.URL, t.TempDir())
+	second := newTestS3Store(t, server.URL, "")
+
+	written := make(map[string]uint64)
+	write := func(store S3Store, data string) {
+		offset, length, err := store.Write(1, "test", 1, 123, []byte(data))
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(data)), length)
+		written[data] = offset
+	}
+	write(first, "a")
+	write(second, "bb")
+	// The first store reserved the offset after "a", which the second store has used
+	// since, so it has to move past what is in the bucket.
+	write(first, "ccc")
+	write(second, "dddd")
+
+	offsets := make(map[uint64]bool)
+	for data, offset := range written {
+		require.False(t, offsets[offset], "offset %d was written twice", offset)
+		offsets[offset] = true
+		for _, store := range []S3Store{first, second} {
+			read, err := store.Read(1, "test", 1, 123, offset, uint64(len(data)))
+			require.NoError(t, err)
+			require.Equal(t, data, string(read))
+		}
+	}
+}
+
+func TestSignV4(t *testing.T) {
+	// The get-vanilla case from the AWS Signature Version 4 test suite.
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	require.NoError(t, err)
+	payloadHash := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	signV4(req, "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "us-east-1", "service", payloadHash, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	require.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31", req.Header.Get("Authorization"))
+}
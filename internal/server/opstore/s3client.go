@@ -0,0 +1,270 @@
+package opstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// s3Client is a minimal client for the parts of the S3 API that the op store needs.
+// Buckets are addressed by path so that it works with S3 compatible servers.
+type s3Client struct {
+	endpoint        string
+	region          string
+	bucket          string
+	accessKeyId     string
+	secretAccessKey string
+	httpClient      *http.Client
+}
+
+type s3Object struct {
+	Key  string
+	Size uint64
+}
+
+func (c s3Client) putObject(key string, data []byte) error {
+	resp, err := c.do(http.MethodPut, key, nil, nil, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp, key)
+}
+
+// errObjectExists is returned by putNewObject when there already is an object at key.
+var errObjectExists = errors.New("object already exists")
+
+// putNewObject stores data at key unless there already is an object there. The bucket
+// checks this, so writers on different servers can't overwrite each other.
+func (c s3Client) putNewObject(key string, data []byte) error {
+	resp, err := c.do(http.MethodPut, key, nil, http.Header{"If-None-Match": {"*"}}, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// S3 answers 409 instead of 412 when a conflicting conditional write is in progress.
+	if resp.StatusCode == http.StatusPreconditionFailed || resp.StatusCode == http.StatusConflict {
+		return fmt.Errorf("s3 object %s: %w", key, errObjectExists)
+	}
+	return checkResponse(resp, key)
+}
+
+// getObject returns an error wrapping os.ErrNotExist if there is no object at key.
+func (c s3Client) getObject(key string) ([]byte, error) {
+	resp, err := c.do(http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	err = checkResponse(resp, key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func (c s3Client) deleteObject(key string) error {
+	resp, err := c.do(http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	err = checkResponse(resp, key)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+type listBucketResult struct {
+	Contents              []s3Object `xml:"Contents"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+// listObjects returns every object with a key starting with prefix.
+func (c s3Client) listObjects(prefix string) ([]s3Object, error) {
+	objects := make([]s3Object, 0)
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		resp, err := c.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		err = checkResponse(resp, prefix)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, result.Contents...)
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (c s3Client) do(method string, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	path := "/" + c.bucket
+	if key != "" {
+		path += "/" + key
+	}
+	u, err := url.Parse(c.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+	u.RawPath = uriEncode(path, false)
+	if query != nil {
+		u.RawQuery = canonicalQuery(query)
+	}
+
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	payloadHash := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	signV4(req, c.accessKeyId, c.secretAccessKey, c.region, "s3", hex.EncodeToString(payloadHash[:]), time.Now())
+
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return httpClient.Do(req)
+}
+
+func checkResponse(resp *http.Response, key string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("s3 object %s: %w", key, os.ErrNotExist)
+	}
+	return fmt.Errorf("s3 request for %s failed with %s: %s", key, resp.Status, body)
+}
+
+// signV4 adds an AWS Signature Version 4 Authorization header to req. The host and
+// every X-Amz header already set on req are signed.
+func signV4(req *http.Request, accessKeyId string, secretAccessKey string, region string, service string, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalURI := req.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKeyId, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent encodes every byte except the unreserved characters, and slashes
+// unless encodeSlash is set, as required by Signature Version 4.
+func uriEncode(s string, encodeSlash bool) string {
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			out.WriteByte(c)
+		} else {
+			fmt.Fprintf(&out, "%%%02X", c)
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s JamsyncServer) CreateBranch(ctx context.Context, in *pb.CreateBranchRequest) (*pb.BranchInfo, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetName() == "" || strings.ContainsAny(in.GetName(), " \t\r\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "branch names can't be empty or contain whitespace")
+	}
+
+	branch, err := s.changestore.CreateBranch(in.GetProjectId(), ownerId, in.GetName(), in.GetParent())
+	if errors.Is(err, changestore.ErrBranchExists) {
+		return nil, branchError(in.GetName(), err)
+	}
+	if err != nil {
+		return nil, branchError(in.GetParent(), err)
+	}
+	return branchToPb(branch), nil
+}
+
+func (s JamsyncServer) ListBranches(ctx context.Context, in *pb.ListBranchesRequest) (*pb.ListBranchesResponse, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	mainHead, err := s.changestore.GetLatestCommittedChange(in.GetProjectId(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+	branches, err := s.changestore.ListBranches(in.GetProjectId(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The main branch starts out with the project, so it was created with its first
+	// change.
+	main := &pb.BranchInfo{
+		Name:         changestore.MainBranch,
+		HeadChangeId: mainHead,
+	}
+	firstChange, err := s.changestore.GetChange(in.GetProjectId(), ownerId, 1)
+	if err == nil {
+		main.Timestamp = timestamppb.New(firstChange.Timestamp)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	pbBranches := make([]*pb.BranchInfo, 0, len(branches)+1)
+	pbBranches = append(pbBranches, main)
+	for _, branch := range branches {
+		pbBranches = append(pbBranches, branchToPb(branch))
+	}
+	return &pb.ListBranchesResponse{
+		Branches: pbBranches,
+	}, nil
+}
+
+func (s JamsyncServer) MergeBranch(ctx context.Context, in *pb.MergeBranchRequest) (*pb.MergeBranchResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetName() == "" || in.GetName() == changestore.MainBranch {
+		return nil, status.Errorf(codes.InvalidArgument, "the main branch can't be merged")
+	}
+	branch, err := s.changestore.GetBranch(in.GetProjectId(), ownerId, in.GetName())
+	if err != nil {
+		return nil, branchError(in.GetName(), err)
+	}
+
+	message := in.GetMessage()
+	if message == "" {
+		message = fmt.Sprintf("Merge branch %s into %s", branch.Name, branch.Parent)
+	}
+	changeId, err := s.merge(userId, in.GetProjectId(), ownerId, branch, message)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MergeBranchResponse{
+		ChangeId: changeId,
+	}, nil
+}
+
+// merge commits the paths that changed on a branch since its merge base to the head
+// of its parent, and moves the merge base up to the head of the branch. Paths that the
+// parent changed differently since the merge base are conflicts, and nothing is
+// committed if there are any.
+func (s JamsyncServer) merge(userId string, projectId uint64, ownerId string, branch changestore.Branch, message string) (uint64, error) {
+	if branch.HeadChangeId == branch.MergeBaseChangeId {
+		return 0, status.Errorf(codes.FailedPrecondition, "nothing to merge, branch %s has no changes since change %d", branch.Name, branch.MergeBaseChangeId)
+	}
+	targetChangeId, err := s.changestore.GetBranchHead(projectId, ownerId, branch.Parent)
+	if err != nil {
+		return 0, branchError(branch.Parent, err)
+	}
+
+	base, err := s.readFileList(projectId, ownerId, branch.MergeBaseChangeId)
+	if err != nil {
+		return 0, err
+	}
+	source, err := s.readFileList(projectId, ownerId, branch.HeadChangeId)
+	if err != nil {
+		return 0, err
+	}
+	target, err := s.readFileList(projectId, ownerId, targetChangeId)
+	if err != nil {
+		return 0, err
+	}
+
+	paths := make(map[string]bool)
+	for filePath := range base.GetFiles() {
+		paths[filePath] = true
+	}
+	for filePath := range source.GetFiles() {
+		paths[filePath] = true
+	}
+	copied := make([]string, 0)
+	deleted := make([]string, 0)
+	conflicts := make([]string, 0)
+	for filePath := range paths {
+		sourceFile, baseFile, targetFile := source.GetFiles()[filePath], base.GetFiles()[filePath], target.GetFiles()[filePath]
+		if sameFile(sourceFile, baseFile) || sameFile(sourceFile, targetFile) {
+			continue
+		}
+		switch {
+		case !sameFile(targetFile, baseFile):
+			conflicts = append(conflicts, filePath)
+		case sourceFile == nil:
+			deleted = append(deleted, filePath)
+		default:
+			copied = append(copied, filePath)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return 0, changeStateError(0, &changestore.ConflictError{
+			BaseChangeId:   branch.MergeBaseChangeId,
+			LatestChangeId: targetChangeId,
+			Paths:          conflicts,
+		})
+	}
+
+	// A parent that already has every change of the branch only needs the merge
+	// base to move.
+	changeId := targetChangeId
+	if len(copied) > 0 || len(deleted) > 0 {
+		changeId, err = s.commitFiles(userId, projectId, ownerId, branch.Parent, message, targetChangeId, target, branch.HeadChangeId, source, copied, deleted)
+		if err != nil {
+			return 0, err
+		}
+	}
+	err = s.changestore.SetBranchMergeBase(projectId, ownerId, branch.Name, branch.HeadChangeId)
+	if err != nil {
+		return 0, err
+	}
+	return changeId, nil
+}
+
+// listBranchCommits returns the ids of the changes committed to a branch in ascending
+// order.
+func (s JamsyncServer) listBranchCommits(projectId uint64, ownerId string, branch string) ([]uint64, error) {
+	if branch != changestore.MainBranch {
+		_, err := s.changestore.GetBranch(projectId, ownerId, branch)
+		if err != nil {
+			return nil, branchError(branch, err)
+		}
+	}
+	commits, err := s.changestore.ListCommits(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	changeIds := make([]uint64, 0)
+	for _, commit := range commits {
+		if commit.Branch == branch {
+			changeIds = append(changeIds, commit.ChangeId)
+		}
+	}
+	return changeIds, nil
+}
+
+// branchError turns the errors of the changestore about branches into statuses.
+func branchError(branch string, err error) error {
+	if branch == "" {
+		branch = changestore.MainBranch
+	}
+	switch {
+	case errors.Is(err, changestore.ErrBranchNotFound):
+		return status.Errorf(codes.NotFound, "branch %s not found", branch)
+	case errors.Is(err, changestore.ErrBranchExists):
+		return status.Errorf(codes.AlreadyExists, "branch %s already exists", branch)
+	}
+	return err
+}
+
+func branchToPb(branch changestore.Branch) *pb.BranchInfo {
+	return &pb.BranchInfo{
+		Name:              branch.Name,
+		Parent:            branch.Parent,
+		ForkChangeId:      branch.ForkChangeId,
+		HeadChangeId:      branch.HeadChangeId,
+		MergeBaseChangeId: branch.MergeBaseChangeId,
+		Timestamp:         timestamppb.New(branch.Timestamp),
+	}
+}
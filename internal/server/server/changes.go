@@ -3,15 +3,20 @@ package server
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"errors"
 	"io"
+	"log"
 
 	"github.com/cespare/xxhash"
 	"github.com/zdgeier/jamsync/gen/pb"
 	"github.com/zdgeier/jamsync/internal/rsync"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/db"
 	"github.com/zdgeier/jamsync/internal/server/serverauth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s JamsyncServer) CreateChange(ctx context.Context, in *pb.CreateChangeRequest) (*pb.CreateChangeResponse, error) {
@@ -20,10 +25,15 @@ func (s JamsyncServer) CreateChange(ctx context.Context, in *pb.CreateChangeRequ
 		return nil, err
 	}
 
-	changeId, err := s.changestore.AddChange(in.GetProjectId(), userId)
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
 	if err != nil {
 		return nil, err
 	}
+
+	changeId, err := s.changestore.AddChange(in.GetProjectId(), ownerId, in.GetBranch(), userId, in.GetMessage(), in.GetHostname(), in.BaseChangeId)
+	if err != nil {
+		return nil, branchError(in.GetBranch(), err)
+	}
 	return &pb.CreateChangeResponse{
 		ChangeId: changeId,
 	}, nil
@@ -37,8 +47,22 @@ func (s JamsyncServer) WriteOperationStream(srv pb.JamsyncAPI_WriteOperationStre
 
 	projectOwner := ""
 	operationProject := uint64(0)
-	var projectId, changeId, pathHash uint64
+	var projectId, changeId, pathHash, streamChangeId, streamPathHash uint64
 	opLocs := make([]*pb.OperationLocations_OperationLocation, 0)
+	var upload *uploadSession
+	var contentHash uint64
+	hashReceived := false
+	// Operations of a stream that is not an upload can't be resumed, so their chunks
+	// are released unless they are accepted.
+	accepted := false
+	defer func() {
+		if upload == nil && !accepted && len(opLocs) > 0 {
+			err := s.releaseUploadedOperations(projectId, projectOwner, streamChangeId, streamPathHash, opLocs)
+			if err != nil {
+				log.Println("could not release the operations of a failed stream:", err)
+			}
+		}
+	}()
 	for {
 		in, err := srv.Recv()
 		if err == io.EOF {
@@ -47,62 +71,156 @@ func (s JamsyncServer) WriteOperationStream(srv pb.JamsyncAPI_WriteOperationStre
 		if err != nil {
 			return err
 		}
-		data, err := proto.Marshal(in)
-		if err != nil {
-			return err
-		}
 		projectId = in.GetProjectId()
 		changeId = in.GetChangeId()
 		pathHash = in.GetPathHash()
 		if operationProject == 0 {
-			owner, err := s.db.GetProjectOwner(projectId)
+			owner, err := s.authorizeProject(projectId, userId, db.RoleWriter)
 			if err != nil {
 				return err
 			}
-			if userId != owner {
-				return status.Errorf(codes.Unauthenticated, "unauthorized")
-			}
 			projectOwner = owner
 			operationProject = projectId
+			streamChangeId = changeId
+			streamPathHash = pathHash
+
+			err = s.requireOpenChange(projectId, projectOwner, userId, changeId)
+			if err != nil {
+				return err
+			}
 		}
 
 		if operationProject != projectId {
-			return status.Errorf(codes.Unauthenticated, "unauthorized")
+			return status.Errorf(codes.PermissionDenied, "unauthorized")
+		}
+		if changeId != streamChangeId {
+			return status.Errorf(codes.InvalidArgument, "operations of a stream must all be for the same change")
 		}
 
-		offset, length, err := s.opstore.Write(projectId, userId, changeId, pathHash, data)
+		if upload == nil && in.GetUploadId() != "" {
+			s.expireUploads()
+			upload, err = s.uploads.acquire(in.GetUploadId(), userId, projectId, projectOwner, changeId, pathHash)
+			if err != nil {
+				return err
+			}
+			defer s.uploads.release(upload)
+		}
+		if upload != nil {
+			if in.GetUploadId() != upload.id || changeId != upload.changeId || pathHash != upload.pathHash {
+				return status.Errorf(codes.InvalidArgument, "operations of upload %s must all be for the same file", upload.id)
+			}
+			if in.GetType() == pb.Operation_OpHash {
+				contentHash = in.GetContentHash()
+				hashReceived = true
+				continue
+			}
+			received := s.uploads.received(upload)
+			if in.GetSeq() < received {
+				// Already written by an earlier attempt.
+				continue
+			}
+			if in.GetSeq() > received {
+				return status.Errorf(codes.OutOfRange, "expected operation %d of upload %s, got %d", received, upload.id, in.GetSeq())
+			}
+			in.UploadId, in.Seq = "", 0
+		}
+
+		operationLocation, err := s.writeOperation(projectId, projectOwner, dataKey(pathHash, changeId), in)
 		if err != nil {
 			return err
 		}
-		operationLocation := &pb.OperationLocations_OperationLocation{
-			Offset: offset,
-			Length: length,
+		if upload != nil {
+			s.uploads.add(upload, operationLocation)
+		} else {
+			opLocs = append(opLocs, operationLocation)
+		}
+	}
+
+	if upload != nil {
+		if !hashReceived {
+			return status.Errorf(codes.InvalidArgument, "upload %s ended without its content hash", upload.id)
+		}
+		opLocs = s.uploads.opLocs(upload)
+		err = s.verifyUpload(projectId, projectOwner, changeId, pathHash, opLocs, contentHash)
+		if err == nil {
+			err = s.recordOperationLocations(projectId, projectOwner, changeId, pathHash, opLocs)
+		}
+		if err != nil {
+			// The operations are unusable, so the upload has to start over.
+			abandonErr := s.abandonUpload(upload)
+			if abandonErr != nil {
+				return abandonErr
+			}
+			return err
+		}
+		s.uploads.remove(upload)
+	} else {
+		err = s.recordOperationLocations(projectId, projectOwner, changeId, pathHash, opLocs)
+		if err != nil {
+			return err
 		}
-		opLocs = append(opLocs, operationLocation)
+		accepted = true
+	}
+	err = s.addChangeFile(projectId, projectOwner, changeId, pathHash)
+	if err != nil {
+		return err
+	}
+
+	return srv.SendAndClose(&pb.WriteOperationStreamResponse{})
+}
+
+// insertOperationLocations records the operations written for a file in a change.
+func (s JamsyncServer) insertOperationLocations(projectId uint64, ownerId string, changeId uint64, pathHash uint64, opLocs []*pb.OperationLocations_OperationLocation) error {
+	err := s.recordOperationLocations(projectId, ownerId, changeId, pathHash, opLocs)
+	if err != nil {
+		return err
+	}
+	return s.addChangeFile(projectId, ownerId, changeId, pathHash)
+}
+
+// recordOperationLocations adds the operations written for a file in a change to the
+// OpLocStore, which owns them from then on. Operations of an earlier upload of the file
+// in the same change are replaced, so their chunks are released.
+func (s JamsyncServer) recordOperationLocations(projectId uint64, ownerId string, changeId uint64, pathHash uint64, opLocs []*pb.OperationLocations_OperationLocation) error {
+	previous, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
 	}
 	err = s.oplocstore.InsertOperationLocations(&pb.OperationLocations{
 		ProjectId: projectId,
-		OwnerId:   projectOwner,
+		OwnerId:   ownerId,
 		ChangeId:  changeId,
 		PathHash:  pathHash,
 		OpLocs:    opLocs,
+		DataKey:   dataKey(pathHash, changeId),
 	})
 	if err != nil {
 		return err
 	}
+	if previous == nil {
+		return nil
+	}
+	return s.releaseOperations(projectId, ownerId, previous)
+}
 
-	return srv.SendAndClose(&pb.WriteOperationStreamResponse{})
+// addChangeFile marks a file as written in a change once its operations are recorded.
+func (s JamsyncServer) addChangeFile(projectId uint64, ownerId string, changeId uint64, pathHash uint64) error {
+	// Any checkpoint at or after this change was materialized without these operations.
+	err := s.opstore.DeleteCheckpoints(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+
+	return s.changestore.AddChangeFiles(projectId, ownerId, changeId, []changestore.ChangeFile{{PathHash: pathHash}})
 }
 
 func (s JamsyncServer) ReadBlockHashes(ctx context.Context, in *pb.ReadBlockHashesRequest) (*pb.ReadBlockHashesResponse, error) {
-	userId, err := serverauth.ParseIdFromCtx(ctx)
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
 	if err != nil {
-		if in.GetProjectId() != 1 {
-			return nil, err
-		}
+		return nil, err
 	}
 
-	targetBuffer, err := s.regenFile(in.GetProjectId(), userId, in.GetPathHash(), in.GetChangeId())
+	targetBuffer, err := s.readVisibleFile(ctx, in.GetProjectId(), ownerId, in.GetPathHash(), in.GetChangeId(), in.GetIncludeOpenChange())
 	if err != nil {
 		return nil, err
 	}
@@ -122,53 +240,166 @@ func (s JamsyncServer) ReadBlockHashes(ctx context.Context, in *pb.ReadBlockHash
 	}, err
 }
 
-func (s JamsyncServer) regenFile(projectId uint64, userId string, pathHash uint64, changeId uint64) (*bytes.Reader, error) {
-	rs := rsync.RSync{UniqueHasher: xxhash.New()}
-	targetBuffer := bytes.NewBuffer([]byte{})
-	result := new(bytes.Buffer)
-	for i := uint64(1); i <= changeId; i++ {
-		operationLocations, err := s.oplocstore.ListOperationLocations(projectId, userId, pathHash, i)
+// checkpointInterval is the number of changes that regenFile may have to apply on top of
+// the nearest checkpoint before it materializes a new one.
+const checkpointInterval = 16
+
+// regenFile returns the content of a file made of the committed changes in the
+// ancestry of changeId, up to changeId.
+func (s JamsyncServer) regenFile(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (*bytes.Reader, error) {
+	return s.regen(projectId, ownerId, pathHash, changeId, false)
+}
+
+// regenOpenFile is like regenFile, but also includes what was uploaded so far to
+// changeId if it is still open.
+func (s JamsyncServer) regenOpenFile(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (*bytes.Reader, error) {
+	return s.regen(projectId, ownerId, pathHash, changeId, true)
+}
+
+// regenBase returns the content of a file before the open change changeId on its
+// branch, which is what the operations uploaded to changeId are a delta against.
+func (s JamsyncServer) regenBase(projectId uint64, ownerId string, pathHash uint64, changeId uint64) (*bytes.Reader, error) {
+	// An open change is left out of reads that don't ask for it.
+	return s.regenFile(projectId, ownerId, pathHash, changeId)
+}
+
+// regen regenerates a file at changeId, which must not be below the baseline change.
+// Operations that compaction removes while they are read make it start over.
+func (s JamsyncServer) regen(projectId uint64, ownerId string, pathHash uint64, changeId uint64, includeOpen bool) (*bytes.Reader, error) {
+	baselineChangeId, err := s.changestore.GetBaselineChange(projectId, ownerId)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if changeId != 0 && changeId < baselineChangeId {
+			return nil, status.Errorf(codes.FailedPrecondition, "change %d was compacted, the oldest change that can be read is %d", changeId, baselineChangeId)
+		}
+		result, err := s.regenFrom(projectId, ownerId, pathHash, changeId, includeOpen, baselineChangeId)
+		latestBaselineChangeId, baselineErr := s.changestore.GetBaselineChange(projectId, ownerId)
+		if baselineErr != nil {
+			return nil, baselineErr
+		}
+		if latestBaselineChangeId == baselineChangeId {
+			return result, err
+		}
+		baselineChangeId = latestBaselineChangeId
+	}
+}
+
+// regenFrom applies the operations of committed changes in the ancestry of changeId,
+// and of changeId itself if includeOpen is set, on top of the nearest checkpoint.
+// Changes before the baseline are skipped, since the baseline change replaces the whole
+// file. Checkpoints are only written below the first change that is still open, since
+// it may be committed later. They are only ever written for the main branch, so that
+// the nearest one is always in the ancestry.
+func (s JamsyncServer) regenFrom(projectId uint64, ownerId string, pathHash uint64, changeId uint64, includeOpen bool, baselineChangeId uint64) (*bytes.Reader, error) {
+	mainChangeId, err := s.changestore.GetMainChange(projectId, ownerId, changeId)
+	if err != nil {
+		return nil, err
+	}
+	checkpointChangeId, checkpoint, err := s.opstore.ReadCheckpoint(projectId, ownerId, pathHash, mainChangeId)
+	if err != nil {
+		return nil, err
+	}
+	if baselineChangeId > 0 && checkpointChangeId < baselineChangeId-1 {
+		checkpointChangeId, checkpoint = baselineChangeId-1, nil
+	}
+	states, err := s.changestore.ListChangeStates(projectId, ownerId, checkpointChangeId, changeId)
+	if err != nil {
+		return nil, err
+	}
+
+	targetBuffer := bytes.NewBuffer(checkpoint)
+	applied := 0
+	lastAppliedChangeId := checkpointChangeId
+	resolved := true
+	writeCheckpoint := func() {
+		if !resolved || applied < checkpointInterval {
+			return
+		}
+		err := s.opstore.WriteCheckpoint(projectId, ownerId, pathHash, lastAppliedChangeId, targetBuffer.Bytes())
 		if err != nil {
-			return nil, err
+			log.Println("could not write checkpoint:", err)
 		}
-		if operationLocations == nil {
+	}
+	for _, state := range states {
+		open := !state.Committed && !state.Aborted
+		if open || state.Id > mainChangeId {
+			writeCheckpoint()
+			resolved = false
+		}
+		if !state.Committed && !(open && includeOpen && state.Id == changeId) {
 			continue
 		}
-		ops := make([]rsync.Operation, 0, len(operationLocations.GetOpLocs()))
-		for _, loc := range operationLocations.GetOpLocs() {
-			b, err := s.opstore.Read(projectId, userId, operationLocations.ChangeId, pathHash, loc.GetOffset(), loc.GetLength())
-			if err != nil {
-				panic(err)
-			}
 
-			op := new(pb.Operation)
-			err = proto.Unmarshal(b, op)
-			if err != nil {
-				panic(err)
-			}
-			ops = append(ops, rsync.PbOperationToRsync(op))
+		operationLocations, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, state.Id)
+		if err != nil {
+			return nil, err
 		}
-		err = rs.ApplyDeltaBatch(result, bytes.NewReader(targetBuffer.Bytes()), ops)
+		if operationLocations == nil {
+			continue
+		}
+		result, err := s.applyOperations(targetBuffer.Bytes(), projectId, ownerId, operationLocations.GetChangeId(), opLocsDataKey(operationLocations), operationLocations.GetOpLocs())
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 		targetBuffer.Reset()
-		targetBuffer.Write(result.Bytes())
-		result.Reset()
+		targetBuffer.Write(result)
+		if resolved {
+			applied += 1
+			lastAppliedChangeId = state.Id
+		}
 	}
+	writeCheckpoint()
+
 	return bytes.NewReader(targetBuffer.Bytes()), nil
 }
 
+// applyOperations returns the result of applying the operations at opLocs, written for
+// a file in changeId under key, to target.
+func (s JamsyncServer) applyOperations(target []byte, projectId uint64, ownerId string, changeId uint64, key uint64, opLocs []*pb.OperationLocations_OperationLocation) ([]byte, error) {
+	ops := make([]rsync.Operation, 0, len(opLocs))
+	for _, loc := range opLocs {
+		op, err := s.readOperation(projectId, ownerId, changeId, key, loc)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, rsync.PbOperationToRsync(op))
+	}
+
+	rs := rsync.RSync{UniqueHasher: xxhash.New()}
+	result := new(bytes.Buffer)
+	err := rs.ApplyDeltaBatch(result, bytes.NewReader(target), ops)
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// readVisibleFile regenerates a file for a read request, which may opt in to see the
+// caller's own open change.
+func (s JamsyncServer) readVisibleFile(ctx context.Context, projectId uint64, ownerId string, pathHash uint64, changeId uint64, includeOpenChange bool) (*bytes.Reader, error) {
+	if !includeOpenChange {
+		return s.regenFile(projectId, ownerId, pathHash, changeId)
+	}
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.requireOpenChange(projectId, ownerId, userId, changeId)
+	if err != nil {
+		return nil, err
+	}
+	return s.regenOpenFile(projectId, ownerId, pathHash, changeId)
+}
+
 func (s JamsyncServer) ReadFile(in *pb.ReadFileRequest, srv pb.JamsyncAPI_ReadFileServer) error {
-	userId, err := serverauth.ParseIdFromCtx(srv.Context())
+	ownerId, err := s.authorizeRead(srv.Context(), in.GetProjectId())
 	if err != nil {
-		// jamsync
-		if in.GetProjectId() != 1 {
-			return err
-		}
+		return err
 	}
 
-	sourceBuffer, err := s.regenFile(in.GetProjectId(), userId, in.GetPathHash(), in.GetChangeId())
+	sourceBuffer, err := s.readVisibleFile(srv.Context(), in.GetProjectId(), ownerId, in.GetPathHash(), in.GetChangeId(), in.GetIncludeOpenChange())
 	if err != nil {
 		return err
 	}
@@ -234,11 +465,32 @@ func (s JamsyncServer) CommitChange(ctx context.Context, in *pb.CommitChangeRequ
 		return nil, err
 	}
 
-	err = s.changestore.CommitChange(in.GetProjectId(), userId, in.GetChangeId())
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
 	if err != nil {
 		return nil, err
 	}
 
+	err = s.requireOpenChange(in.GetProjectId(), ownerId, userId, in.GetChangeId())
+	if err != nil {
+		return nil, err
+	}
+	err = s.validateChange(in.GetProjectId(), ownerId, in.GetChangeId())
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]changestore.ChangeFile, 0, len(in.GetPaths()))
+	for _, path := range in.GetPaths() {
+		files = append(files, changestore.ChangeFile{PathHash: pathToHash(path), Path: path})
+	}
+	if in.BaseChangeId != nil && in.GetBaseChangeId() >= in.GetChangeId() {
+		return nil, status.Errorf(codes.InvalidArgument, "base change %d is not before change %d", in.GetBaseChangeId(), in.GetChangeId())
+	}
+	err = s.changestore.CommitChange(in.GetProjectId(), ownerId, in.GetChangeId(), in.GetMessage(), files, in.BaseChangeId)
+	if err != nil {
+		return nil, changeStateError(in.GetChangeId(), err)
+	}
+
 	s.hub.Broadcast(&pb.ChangeStreamMessage{
 		ProjectId: in.GetProjectId(),
 		UserId:    userId,
@@ -247,6 +499,149 @@ func (s JamsyncServer) CommitChange(ctx context.Context, in *pb.CommitChangeRequ
 	return &pb.CommitChangeResponse{}, nil
 }
 
+// AbortChange discards an open change so that none of what was uploaded in it is
+// ever read.
+func (s JamsyncServer) AbortChange(ctx context.Context, in *pb.AbortChangeRequest) (*pb.AbortChangeResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.requireOpenChange(in.GetProjectId(), ownerId, userId, in.GetChangeId())
+	if err != nil {
+		return nil, err
+	}
+	err = s.abortChange(in.GetProjectId(), ownerId, in.GetChangeId())
+	if err != nil {
+		return nil, changeStateError(in.GetChangeId(), err)
+	}
+	return &pb.AbortChangeResponse{}, nil
+}
+
+// abortChange marks a change as aborted and removes the operations written in it,
+// along with any checkpoint that may include them.
+func (s JamsyncServer) abortChange(projectId uint64, ownerId string, changeId uint64) error {
+	err := s.changestore.AbortChange(projectId, ownerId, changeId)
+	if err != nil {
+		return err
+	}
+
+	change, err := s.changestore.GetChange(projectId, ownerId, changeId)
+	if err != nil {
+		return err
+	}
+	for _, file := range change.Files {
+		opLocs, err := s.oplocstore.ListOperationLocations(projectId, ownerId, file.PathHash, changeId)
+		if err != nil {
+			return err
+		}
+		if opLocs == nil {
+			continue
+		}
+		err = s.oplocstore.DeleteOperationLocations(projectId, ownerId, file.PathHash, changeId)
+		if err != nil {
+			return err
+		}
+		err = s.opstore.DeleteCheckpoints(projectId, ownerId, file.PathHash, changeId)
+		if err != nil {
+			return err
+		}
+		err = s.deleteOperations(projectId, ownerId, opLocs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireOpenChange returns an error unless changeId is an open change created by
+// userId. Changes recorded before authors were kept can be used by any writer.
+func (s JamsyncServer) requireOpenChange(projectId uint64, ownerId string, userId string, changeId uint64) error {
+	change, err := s.changestore.GetChange(projectId, ownerId, changeId)
+	if err != nil {
+		return changeStateError(changeId, err)
+	}
+	if change.AuthorId != "" && change.AuthorId != userId {
+		return status.Errorf(codes.PermissionDenied, "change %d belongs to another user", changeId)
+	}
+	if change.Committed || change.Aborted {
+		return changeStateError(changeId, changestore.ErrChangeNotOpen)
+	}
+	return nil
+}
+
+func changeStateError(changeId uint64, err error) error {
+	var conflict *changestore.ConflictError
+	switch {
+	case errors.As(err, &conflict):
+		st, detailErr := status.New(codes.Aborted, conflict.Error()).WithDetails(&pb.CommitConflict{
+			BaseChangeId:   conflict.BaseChangeId,
+			LatestChangeId: conflict.LatestChangeId,
+			Paths:          conflict.Paths,
+		})
+		if detailErr != nil {
+			return detailErr
+		}
+		return st.Err()
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "change %d not found", changeId)
+	case errors.Is(err, changestore.ErrChangeNotOpen):
+		return status.Errorf(codes.FailedPrecondition, "change %d was already committed or aborted", changeId)
+	case errors.Is(err, changestore.ErrChangeNotCommitted):
+		return status.Errorf(codes.FailedPrecondition, "change %d is not committed", changeId)
+	}
+	return err
+}
+
+// validateChange checks that every file whose content changed in the file list
+// uploaded in a change also had its content uploaded in that change. A change that
+// doesn't upload a file list is not checked.
+func (s JamsyncServer) validateChange(projectId uint64, ownerId string, changeId uint64) error {
+	fileListLocs, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathToHash(fileListPath), changeId)
+	if err != nil {
+		return err
+	}
+	if fileListLocs == nil {
+		return nil
+	}
+
+	fileList, err := s.readOpenFileList(projectId, ownerId, changeId)
+	if err != nil {
+		return err
+	}
+	previousData, err := s.regenBase(projectId, ownerId, pathToHash(fileListPath), changeId)
+	if err != nil {
+		return err
+	}
+	previous, err := parseFileList(previousData)
+	if err != nil {
+		return err
+	}
+
+	for filePath, file := range fileList.GetFiles() {
+		if file.GetDir() || file.GetSymlinkTarget() != "" {
+			continue
+		}
+		prev, found := previous.GetFiles()[filePath]
+		if found && !prev.GetDir() && prev.GetSymlinkTarget() == "" && prev.GetHash() == file.GetHash() {
+			continue
+		}
+		opLocs, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathToHash(filePath), changeId)
+		if err != nil {
+			return err
+		}
+		if opLocs == nil {
+			return status.Errorf(codes.FailedPrecondition, "%s is in the file list of change %d but its content was not uploaded", filePath, changeId)
+		}
+	}
+	return nil
+}
+
 func (s JamsyncServer) ListCommittedChanges(ctx context.Context, in *pb.ListCommittedChangesRequest) (*pb.ListCommittedChangesResponse, error) {
 	userId, err := serverauth.ParseIdFromCtx(ctx)
 	if err != nil {
@@ -255,12 +650,26 @@ func (s JamsyncServer) ListCommittedChanges(ctx context.Context, in *pb.ListComm
 		}
 	}
 
-	projectId, err := s.db.GetProjectId(in.GetProjectName(), userId)
+	var projectId uint64
+	if in.GetProjectName() == "" && in.GetProjectId() != 0 {
+		projectId = in.GetProjectId()
+	} else {
+		projectId, err = s.db.GetProjectId(in.GetProjectName(), userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ownerId, err := s.authorizeProject(projectId, userId, db.RoleReader)
 	if err != nil {
 		return nil, err
 	}
 
-	changeIds, err := s.changestore.ListCommittedChanges(projectId, userId)
+	branch := in.GetBranch()
+	if branch == "" {
+		branch = changestore.MainBranch
+	}
+	changeIds, err := s.listBranchCommits(projectId, ownerId, branch)
 	if err != nil {
 		return nil, err
 	}
@@ -270,12 +679,103 @@ func (s JamsyncServer) ListCommittedChanges(ctx context.Context, in *pb.ListComm
 	}, nil
 }
 
+func (s JamsyncServer) GetChange(ctx context.Context, in *pb.GetChangeRequest) (*pb.ChangeInfo, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := s.changestore.GetChange(in.GetProjectId(), ownerId, in.GetChangeId())
+	if err != nil {
+		return nil, changeStateError(in.GetChangeId(), err)
+	}
+	return changeToPb(change), nil
+}
+
+const (
+	defaultChangesPageSize = 50
+	maxChangesPageSize     = 500
+)
+
+// ListChanges returns the changes of a project newest first. The next page starts at
+// the change before next_page_token, which is 0 on the last page.
+func (s JamsyncServer) ListChanges(ctx context.Context, in *pb.ListChangesRequest) (*pb.ListChangesResponse, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(in.GetPageSize())
+	if pageSize == 0 {
+		pageSize = defaultChangesPageSize
+	} else if pageSize > maxChangesPageSize {
+		pageSize = maxChangesPageSize
+	}
+
+	changes, err := s.changestore.ListChanges(in.GetProjectId(), ownerId, in.GetPageToken(), pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken uint64
+	if len(changes) > pageSize {
+		changes = changes[:pageSize]
+		nextPageToken = changes[len(changes)-1].Id
+	}
+
+	pbChanges := make([]*pb.ChangeInfo, 0, len(changes))
+	for _, change := range changes {
+		pbChanges = append(pbChanges, changeToPb(change))
+	}
+	return &pb.ListChangesResponse{
+		Changes:       pbChanges,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func changeToPb(change changestore.Change) *pb.ChangeInfo {
+	files := make([]*pb.ChangeInfo_ChangeFile, 0, len(change.Files))
+	for _, file := range change.Files {
+		files = append(files, &pb.ChangeInfo_ChangeFile{
+			PathHash: file.PathHash,
+			Path:     file.Path,
+		})
+	}
+
+	info := &pb.ChangeInfo{
+		ChangeId:  change.Id,
+		AuthorId:  change.AuthorId,
+		Message:   change.Message,
+		Hostname:  change.Hostname,
+		Timestamp: timestamppb.New(change.Timestamp),
+		Committed: change.Committed,
+		Files:     files,
+		Aborted:   change.Aborted,
+		Branch:    change.Branch,
+	}
+	if change.Committed {
+		info.CommitTimestamp = timestamppb.New(change.CommitTimestamp)
+	}
+	return info
+}
+
+func pathToHash(path string) uint64 {
+	h := xxhash.New()
+	h.Write([]byte(path))
+	return h.Sum64()
+}
+
 func (s JamsyncServer) ChangeStream(in *pb.ChangeStreamRequest, srv pb.JamsyncAPI_ChangeStreamServer) error {
 	userId, err := serverauth.ParseIdFromCtx(srv.Context())
 	if err != nil {
 		return err
 	}
 
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleReader)
+	if err != nil {
+		return err
+	}
+
 	client := s.hub.Register(in.ProjectId, userId)
 
 	for changeStreamMessage := range client.Send {
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// minChunkSize is the smallest operation data moved into the chunk store. Smaller data
+// is cheaper to keep inline than to look up.
+const minChunkSize = 256
+
+// dataKey is the OpStore key that the operations written for a path in a change are
+// stored under. Keeping changes apart lets the operations of one be deleted when it is
+// aborted or compacted away.
+func dataKey(pathHash uint64, changeId uint64) uint64 {
+	return xxhash.Sum64String(fmt.Sprintf("%d/%d", pathHash, changeId))
+}
+
+// opLocsDataKey returns the OpStore key of the operations at opLocs. Operations written
+// before changes had their own key are stored under the path hash.
+func opLocsDataKey(opLocs *pb.OperationLocations) uint64 {
+	if opLocs.GetDataKey() == 0 {
+		return opLocs.GetPathHash()
+	}
+	return opLocs.GetDataKey()
+}
+
+// writeOperation stores an operation for op.ChangeId under key, moving its data into
+// the chunk store so that data seen before is not stored again.
+func (s JamsyncServer) writeOperation(projectId uint64, ownerId string, key uint64, op *pb.Operation) (*pb.OperationLocations_OperationLocation, error) {
+	if op.GetType() == pb.Operation_OpData && len(op.GetData()) >= minChunkSize {
+		hash, err := s.chunkstore.Put(op.GetData())
+		if err != nil {
+			return nil, err
+		}
+		op = proto.Clone(op).(*pb.Operation)
+		op.DataHash = hash
+		op.Data = nil
+	}
+
+	data, err := proto.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	offset, length, err := s.opstore.Write(projectId, ownerId, op.GetChangeId(), key, data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OperationLocations_OperationLocation{
+		Offset: offset,
+		Length: length,
+	}, nil
+}
+
+// readOperation reads an operation written by writeOperation with its data restored.
+func (s JamsyncServer) readOperation(projectId uint64, ownerId string, changeId uint64, key uint64, loc *pb.OperationLocations_OperationLocation) (*pb.Operation, error) {
+	op, err := s.readStoredOperation(projectId, ownerId, changeId, key, loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(op.GetDataHash()) > 0 {
+		op.Data, err = s.chunkstore.Get(op.GetDataHash())
+		if err != nil {
+			return nil, err
+		}
+		op.DataHash = nil
+	}
+	return op, nil
+}
+
+// readStoredOperation reads an operation as it was stored, with a data hash in place of
+// data that was moved into the chunk store.
+func (s JamsyncServer) readStoredOperation(projectId uint64, ownerId string, changeId uint64, key uint64, loc *pb.OperationLocations_OperationLocation) (*pb.Operation, error) {
+	b, err := s.opstore.Read(projectId, ownerId, changeId, key, loc.GetOffset(), loc.GetLength())
+	if err != nil {
+		return nil, err
+	}
+
+	op := new(pb.Operation)
+	err = proto.Unmarshal(b, op)
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// releaseOperations removes the chunk references held by the operations at opLocs.
+// It must be called once, when the operations are no longer used.
+func (s JamsyncServer) releaseOperations(projectId uint64, ownerId string, opLocs *pb.OperationLocations) error {
+	for _, loc := range opLocs.GetOpLocs() {
+		op, err := s.readStoredOperation(projectId, ownerId, opLocs.GetChangeId(), opLocsDataKey(opLocs), loc)
+		if err != nil {
+			return err
+		}
+		if len(op.GetDataHash()) > 0 {
+			err = s.chunkstore.Release(op.GetDataHash())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteOperations removes the operations at opLocs, which must no longer be listed in
+// the OpLocStore. Operations stored under the path hash share it with other changes
+// and are left in place.
+func (s JamsyncServer) deleteOperations(projectId uint64, ownerId string, opLocs *pb.OperationLocations) error {
+	err := s.releaseOperations(projectId, ownerId, opLocs)
+	if err != nil {
+		return err
+	}
+	if opLocs.GetDataKey() == 0 {
+		return nil
+	}
+	return s.opstore.DeleteOperations(projectId, ownerId, opLocs.GetDataKey())
+}
@@ -0,0 +1,350 @@
+package server
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+)
+
+const compactInterval = 24 * time.Hour
+
+// RetentionPolicy decides which committed changes keep their own operations when a
+// project is compacted. A change is retained if it is one of the last KeepChanges
+// committed changes or was committed within KeepDuration. The newest change that is
+// not retained becomes the baseline, which keeps the full content of every file, and
+// the operations of the changes before it are deleted. The zero policy disables
+// compaction.
+type RetentionPolicy struct {
+	KeepChanges  int
+	KeepDuration time.Duration
+}
+
+func (p RetentionPolicy) Enabled() bool {
+	return p.KeepChanges > 0 || p.KeepDuration > 0
+}
+
+// runCompactor compacts every project by policy every interval until stop is closed.
+func (s JamsyncServer) runCompactor(interval time.Duration, policy RetentionPolicy, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		err := s.compactProjects(policy, time.Now())
+		if err != nil {
+			log.Println("could not compact projects:", err)
+		}
+	}
+}
+
+// compactProjects compacts every project by policy as of now.
+func (s JamsyncServer) compactProjects(policy RetentionPolicy, now time.Time) error {
+	projects, err := s.db.ListAllProjects()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		ownerId, err := s.db.GetProjectOwner(project.Id)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Deleted since it was listed.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		previous, err := s.changestore.GetBaselineChange(project.Id, ownerId)
+		if err != nil {
+			return err
+		}
+		baseline, err := s.compactProject(project.Id, ownerId, policy, now)
+		if err != nil {
+			return fmt.Errorf("project %d: %w", project.Id, err)
+		}
+		if baseline != previous {
+			log.Printf("compacted project %d up to change %d", project.Id, baseline)
+		}
+	}
+	return nil
+}
+
+// baselineFor returns the newest change committed to the main branch that policy does
+// not retain, or 0 if every change is retained. Changes at or after a nonzero limit
+// can't be the baseline.
+func baselineFor(commits []changestore.Commit, limit uint64, policy RetentionPolicy, now time.Time) uint64 {
+	mainCommits := make([]changestore.Commit, 0, len(commits))
+	for _, commit := range commits {
+		if commit.Branch == changestore.MainBranch {
+			mainCommits = append(mainCommits, commit)
+		}
+	}
+
+	cutoff := now.Add(-policy.KeepDuration)
+	for i := len(mainCommits) - 1 - policy.KeepChanges; i >= 0; i-- {
+		commit := mainCommits[i]
+		if limit != 0 && commit.ChangeId >= limit {
+			continue
+		}
+		if policy.KeepDuration > 0 && commit.Timestamp.After(cutoff) {
+			continue
+		}
+		return commit.ChangeId
+	}
+	return 0
+}
+
+// baselineKey is the OpStore key of the operations that compaction writes for the full
+// content of a path at a baseline change.
+func baselineKey(pathHash uint64, changeId uint64) uint64 {
+	return xxhash.Sum64String(fmt.Sprintf("%d/%d/baseline", pathHash, changeId))
+}
+
+// compactProject moves the baseline of a project forward as far as policy allows and
+// returns it. It runs in two phases so that reads never block:
+//
+//  1. Every path gets its content at the new baseline written as the operations of the
+//     baseline change, and retained changes get their own copy of operations that
+//     are still in the path's shared data. Reads are unaffected, since the content of
+//     every change stays the same.
+//  2. The baseline is recorded, after which reads skip the changes before it, and the
+//     operations of those changes are deleted.
+//
+// A read that was already running when operations were deleted notices the baseline
+// moving and starts over.
+func (s JamsyncServer) compactProject(projectId uint64, ownerId string, policy RetentionPolicy, now time.Time) (uint64, error) {
+	previous, err := s.changestore.GetBaselineChange(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	commits, err := s.changestore.ListCommits(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	openChanges, err := s.changestore.ListOpenChanges(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	branches, err := s.changestore.ListBranches(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	tags, err := s.changestore.ListTags(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	// The oldest open change may still be committed before the changes after it,
+	// branches build on the main branch up to where they were forked and tagged changes
+	// have to stay readable.
+	limit := uint64(0)
+	if len(openChanges) > 0 {
+		limit = openChanges[0].Id
+	}
+	for _, branch := range branches {
+		if limit == 0 || branch.ForkChangeId+1 < limit {
+			limit = branch.ForkChangeId + 1
+		}
+	}
+	if len(tags) > 0 && (limit == 0 || tags[0].ChangeId+1 < limit) {
+		limit = tags[0].ChangeId + 1
+	}
+	baseline := baselineFor(commits, limit, policy, now)
+	if baseline <= previous {
+		return previous, nil
+	}
+
+	pathChanges, err := s.changestore.ListPathChanges(projectId, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	committed := make(map[uint64]bool, len(commits))
+	for _, commit := range commits {
+		committed[commit.ChangeId] = true
+	}
+
+	replaced := make(map[uint64]*pb.OperationLocations)
+	for pathHash, changeIds := range pathChanges {
+		opLocs, err := s.writeBaseline(projectId, ownerId, pathHash, baseline)
+		if err != nil {
+			return 0, err
+		}
+		if opLocs != nil {
+			replaced[pathHash] = opLocs
+		}
+		for _, changeId := range changeIds {
+			if changeId > baseline && committed[changeId] {
+				err = s.moveSharedOperations(projectId, ownerId, pathHash, changeId)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+
+	err = s.changestore.SetBaselineChange(projectId, ownerId, baseline)
+	if err != nil {
+		return 0, err
+	}
+
+	for pathHash, changeIds := range pathChanges {
+		if opLocs, found := replaced[pathHash]; found {
+			err = s.deleteOperations(projectId, ownerId, opLocs)
+			if err != nil {
+				return 0, err
+			}
+		}
+		dropped := changeIds
+		if previous != 0 {
+			dropped = append([]uint64{previous}, changeIds...)
+		}
+		shared := false
+		for _, changeId := range dropped {
+			if changeId >= baseline {
+				opLocs, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, changeId)
+				if err != nil {
+					return 0, err
+				}
+				shared = shared || (opLocs != nil && opLocs.GetDataKey() == 0)
+				continue
+			}
+			err = s.dropOperations(projectId, ownerId, pathHash, changeId)
+			if err != nil {
+				return 0, err
+			}
+		}
+		// Shared data that no change refers to anymore also holds the operations of
+		// aborted changes and failed uploads.
+		if !shared {
+			err = s.opstore.DeleteOperations(projectId, ownerId, pathHash)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	return baseline, nil
+}
+
+// writeBaseline replaces the operations of a path in the baseline change with ones
+// that write its full content, so that the changes before can be dropped. It returns
+// the operation locations that were replaced, which are still in use until the
+// baseline is recorded. A path that was already rewritten for this baseline is left
+// alone.
+func (s JamsyncServer) writeBaseline(projectId uint64, ownerId string, pathHash uint64, baseline uint64) (*pb.OperationLocations, error) {
+	current, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, baseline)
+	if err != nil {
+		return nil, err
+	}
+	key := baselineKey(pathHash, baseline)
+	if current != nil && current.GetDataKey() == key {
+		return nil, nil
+	}
+
+	content, err := s.regenFile(projectId, ownerId, pathHash, baseline)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	// Without operations a path is empty, so an empty path that wasn't written in the
+	// baseline change needs none.
+	if len(data) == 0 && current == nil {
+		return nil, nil
+	}
+
+	opLocs, err := s.writeDelta(projectId, ownerId, baseline, pathHash, key, bytes.NewReader(nil), data)
+	if err != nil {
+		return nil, err
+	}
+	err = s.oplocstore.InsertOperationLocations(&pb.OperationLocations{
+		ProjectId: projectId,
+		OwnerId:   ownerId,
+		ChangeId:  baseline,
+		PathHash:  pathHash,
+		OpLocs:    opLocs,
+		DataKey:   key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Checkpoints before the baseline can't be read anymore.
+	err = s.opstore.DeleteCheckpoints(projectId, ownerId, pathHash, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		err = s.opstore.WriteCheckpoint(projectId, ownerId, pathHash, baseline, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+// moveSharedOperations copies the operations of a path in a change out of the data
+// shared by every change of the path, so that the shared data can be deleted. The
+// copies keep the chunk references of the originals.
+func (s JamsyncServer) moveSharedOperations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	shared, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+	if shared == nil || shared.GetDataKey() != 0 {
+		return nil
+	}
+
+	key := dataKey(pathHash, changeId)
+	opLocs := make([]*pb.OperationLocations_OperationLocation, 0, len(shared.GetOpLocs()))
+	for _, loc := range shared.GetOpLocs() {
+		data, err := s.opstore.Read(projectId, ownerId, changeId, pathHash, loc.GetOffset(), loc.GetLength())
+		if err != nil {
+			return err
+		}
+		offset, length, err := s.opstore.Write(projectId, ownerId, changeId, key, data)
+		if err != nil {
+			return err
+		}
+		opLocs = append(opLocs, &pb.OperationLocations_OperationLocation{
+			Offset: offset,
+			Length: length,
+		})
+	}
+	return s.oplocstore.InsertOperationLocations(&pb.OperationLocations{
+		ProjectId: projectId,
+		OwnerId:   ownerId,
+		ChangeId:  changeId,
+		PathHash:  pathHash,
+		OpLocs:    opLocs,
+		DataKey:   key,
+	})
+}
+
+// dropOperations deletes the operations of a path in a change before the baseline.
+// The locations go first, so that an interrupted compaction leaks chunk references
+// rather than releasing them twice.
+func (s JamsyncServer) dropOperations(projectId uint64, ownerId string, pathHash uint64, changeId uint64) error {
+	opLocs, err := s.oplocstore.ListOperationLocations(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+	if opLocs == nil {
+		return nil
+	}
+	err = s.oplocstore.DeleteOperationLocations(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+	return s.deleteOperations(projectId, ownerId, opLocs)
+}
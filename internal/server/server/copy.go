@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CopyFile seeds a path with the content of another path so that moved files don't
+// have to be uploaded again.
+func (s JamsyncServer) CopyFile(ctx context.Context, in *pb.CopyFileRequest) (*pb.CopyFileResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetChangeId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "change id is required")
+	}
+	if in.GetToPathHash() == pathToHash(fileListPath) {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot copy over the file list")
+	}
+	err = s.requireOpenChange(in.GetProjectId(), ownerId, userId, in.GetChangeId())
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.regenBase(in.GetProjectId(), ownerId, in.GetFromPathHash(), in.GetChangeId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(from)
+	if err != nil {
+		return nil, err
+	}
+	if xxhash.Sum64(data) != in.GetHash() {
+		return nil, status.Errorf(codes.FailedPrecondition, "source content does not match hash %d", in.GetHash())
+	}
+
+	err = s.writeFile(in.GetProjectId(), ownerId, in.GetChangeId(), in.GetToPathHash(), data)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CopyFileResponse{}, nil
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/diff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change.
+const diffContextLines = 3
+
+// DiffFile compares a file between two changes. A to_change of 0 compares against the
+// head of the requested branch and a path missing from either change is treated as empty.
+func (s JamsyncServer) DiffFile(ctx context.Context, in *pb.DiffFileRequest) (*pb.DiffFileResponse, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	filePath := path.Clean(in.GetPath())
+	toChange := in.GetToChange()
+	if toChange == 0 {
+		toChange, err = s.changestore.GetBranchHead(in.GetProjectId(), ownerId, in.GetBranch())
+		if err != nil {
+			return nil, branchError(in.GetBranch(), err)
+		}
+	}
+
+	fromFile, err := s.fileAtChange(in.GetProjectId(), ownerId, filePath, in.GetFromChange())
+	if err != nil {
+		return nil, err
+	}
+	toFile, err := s.fileAtChange(in.GetProjectId(), ownerId, filePath, toChange)
+	if err != nil {
+		return nil, err
+	}
+	if fromFile == nil && toFile == nil {
+		return nil, status.Errorf(codes.NotFound, "%s not found at change %d or %d", filePath, in.GetFromChange(), toChange)
+	}
+	if fromFile.GetSymlinkTarget() != "" || toFile.GetSymlinkTarget() != "" {
+		return diffSymlink(filePath, fromFile, toFile), nil
+	}
+
+	from, err := s.readFileAtChange(in.GetProjectId(), ownerId, filePath, fromFile, in.GetFromChange())
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.readFileAtChange(in.GetProjectId(), ownerId, filePath, toFile, toChange)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DiffFileResponse{
+		Changed:  (fromFile == nil) != (toFile == nil) || string(from) != string(to),
+		FromSize: uint64(len(from)),
+		ToSize:   uint64(len(to)),
+	}
+	if !resp.Changed {
+		return resp, nil
+	}
+	if diff.IsBinary(from) || diff.IsBinary(to) {
+		resp.Binary = true
+		resp.Diff = fmt.Sprintf("Binary file %s changed (%d bytes to %d bytes)\n", filePath, len(from), len(to))
+		return resp, nil
+	}
+
+	fromName, toName := "a/"+filePath, "b/"+filePath
+	if fromFile == nil {
+		fromName = "/dev/null"
+	}
+	if toFile == nil {
+		toName = "/dev/null"
+	}
+	resp.Diff = diff.Unified(fromName, toName, diff.SplitLines(from), diff.SplitLines(to), diffContextLines)
+	return resp, nil
+}
+
+// diffSymlink compares a path that is a symbolic link on at least one side by what it
+// points to, since the link has no content of its own to diff.
+func diffSymlink(filePath string, fromFile, toFile *pb.File) *pb.DiffFileResponse {
+	resp := &pb.DiffFileResponse{
+		Symlink: true,
+		Changed: (fromFile == nil) != (toFile == nil) || fromFile.GetSymlinkTarget() != toFile.GetSymlinkTarget(),
+	}
+	if resp.Changed {
+		resp.Diff = fmt.Sprintf("%s changed from %s to %s\n", filePath, describeFile(fromFile), describeFile(toFile))
+	}
+	return resp
+}
+
+func describeFile(file *pb.File) string {
+	switch {
+	case file == nil:
+		return "nothing"
+	case file.GetSymlinkTarget() != "":
+		return "a symbolic link to " + file.GetSymlinkTarget()
+	}
+	return "a file"
+}
+
+// fileAtChange returns the metadata of a file at a change, or nil if it didn't exist.
+func (s JamsyncServer) fileAtChange(projectId uint64, ownerId string, filePath string, changeId uint64) (*pb.File, error) {
+	if changeId == 0 {
+		return nil, nil
+	}
+	fileList, err := s.readFileList(projectId, ownerId, changeId)
+	if err != nil {
+		return nil, err
+	}
+	file := fileList.GetFiles()[filePath]
+	if file.GetDir() {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is a directory", filePath)
+	}
+	return file, nil
+}
+
+// readFileAtChange returns the content file had at a change, which is empty if it didn't
+// exist.
+func (s JamsyncServer) readFileAtChange(projectId uint64, ownerId string, filePath string, file *pb.File, changeId uint64) ([]byte, error) {
+	if file == nil {
+		return nil, nil
+	}
+	data, err := s.regenFile(projectId, ownerId, pathToHash(filePath), changeId)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(data)
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"io"
+	"sort"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// snapshotChunkSize is the most archive data sent in one SnapshotChunk.
+const snapshotChunkSize = 64 * 1024
+
+func (s JamsyncServer) ExportSnapshot(in *pb.ExportSnapshotRequest, srv pb.JamsyncAPI_ExportSnapshotServer) error {
+	ownerId, err := s.authorizeRead(srv.Context(), in.GetProjectId())
+	if err != nil {
+		return err
+	}
+
+	changeId := in.GetChangeId()
+	if changeId == 0 {
+		changeId, err = s.changestore.GetLatestCommittedChange(in.GetProjectId(), ownerId)
+		if err != nil {
+			return err
+		}
+	}
+	fileList, err := s.readFileList(in.GetProjectId(), ownerId, changeId)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(snapshotWriter{srv}, snapshotChunkSize)
+	var archive snapshotArchive
+	switch in.GetFormat() {
+	case pb.ExportSnapshotRequest_TarGz:
+		archive = newTarGzArchive(w)
+	case pb.ExportSnapshotRequest_Zip:
+		archive = zipArchive{zip.NewWriter(w)}
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown snapshot format %s", in.GetFormat())
+	}
+
+	err = s.writeSnapshot(in.GetProjectId(), ownerId, changeId, fileList, archive)
+	if err != nil {
+		return err
+	}
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// writeSnapshot adds every file in fileList to archive with its content at changeId.
+// Paths are added in sorted order, so directories come before what is in them.
+func (s JamsyncServer) writeSnapshot(projectId uint64, ownerId string, changeId uint64, fileList *pb.FileMetadata, archive snapshotArchive) error {
+	paths := make([]string, 0, len(fileList.GetFiles()))
+	for filePath := range fileList.GetFiles() {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+
+	for _, filePath := range paths {
+		file := fileList.GetFiles()[filePath]
+		header := &tar.Header{
+			Name:    filePath,
+			Mode:    int64(file.GetMode()),
+			ModTime: file.GetModTime().AsTime(),
+		}
+		switch {
+		case file.GetSymlinkTarget() != "":
+			header.Typeflag = tar.TypeSymlink
+			header.Linkname = file.GetSymlinkTarget()
+			header.Mode = 0o777
+			err := archive.WriteEntry(header, nil)
+			if err != nil {
+				return err
+			}
+		case file.GetDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			if header.Mode == 0 {
+				header.Mode = 0o755
+			}
+			err := archive.WriteEntry(header, nil)
+			if err != nil {
+				return err
+			}
+		default:
+			content, err := s.regenFile(projectId, ownerId, pathToHash(filePath), changeId)
+			if err != nil {
+				return err
+			}
+			header.Typeflag = tar.TypeReg
+			header.Size = int64(content.Len())
+			if header.Mode == 0 {
+				header.Mode = 0o644
+			}
+			err = archive.WriteEntry(header, content)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// snapshotArchive is a format that snapshots can be exported in. Entries are described
+// by tar headers, which every format can be made from.
+type snapshotArchive interface {
+	// WriteEntry adds an entry, reading the content of regular files from content.
+	WriteEntry(header *tar.Header, content io.Reader) error
+	Close() error
+}
+
+type tarGzArchive struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func newTarGzArchive(w io.Writer) tarGzArchive {
+	gz := gzip.NewWriter(w)
+	return tarGzArchive{gz: gz, tw: tar.NewWriter(gz)}
+}
+
+func (a tarGzArchive) WriteEntry(header *tar.Header, content io.Reader) error {
+	err := a.tw.WriteHeader(header)
+	if err != nil {
+		return err
+	}
+	if header.Typeflag != tar.TypeReg {
+		return nil
+	}
+	_, err = io.Copy(a.tw, content)
+	return err
+}
+
+func (a tarGzArchive) Close() error {
+	err := a.tw.Close()
+	if err != nil {
+		return err
+	}
+	return a.gz.Close()
+}
+
+type zipArchive struct {
+	zw *zip.Writer
+}
+
+func (a zipArchive) WriteEntry(header *tar.Header, content io.Reader) error {
+	zipHeader, err := zip.FileInfoHeader(header.FileInfo())
+	if err != nil {
+		return err
+	}
+	zipHeader.Name = header.Name
+	if header.Typeflag == tar.TypeReg {
+		zipHeader.Method = zip.Deflate
+	}
+	w, err := a.zw.CreateHeader(zipHeader)
+	if err != nil {
+		return err
+	}
+	switch header.Typeflag {
+	case tar.TypeReg:
+		_, err = io.Copy(w, content)
+	case tar.TypeSymlink:
+		// Zip stores the target of a symbolic link as its content.
+		_, err = io.WriteString(w, header.Linkname)
+	}
+	return err
+}
+
+func (a zipArchive) Close() error {
+	return a.zw.Close()
+}
+
+// snapshotWriter sends what is written to it as SnapshotChunks of at most
+// snapshotChunkSize bytes.
+type snapshotWriter struct {
+	srv pb.JamsyncAPI_ExportSnapshotServer
+}
+
+func (w snapshotWriter) Write(p []byte) (int, error) {
+	written := 0
+	for written < len(p) {
+		end := written + snapshotChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		err := w.srv.Send(&pb.SnapshotChunk{Data: p[written:end]})
+		if err != nil {
+			return written, err
+		}
+		written = end
+	}
+	return written, nil
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authorizeProject checks that userId has at least the required role in a project and
+// returns the project owner, whose id is used to locate the project's data. Archived
+// projects can't be written to.
+func (s JamsyncServer) authorizeProject(projectId uint64, userId string, required db.Role) (string, error) {
+	role, err := s.db.GetProjectRole(projectId, userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Errorf(codes.NotFound, "project not found")
+	}
+	if err != nil {
+		return "", err
+	}
+	if role < required {
+		return "", status.Errorf(codes.PermissionDenied, "unauthorized")
+	}
+	if required == db.RoleWriter {
+		archived, err := s.db.IsProjectArchived(projectId)
+		if err != nil {
+			return "", err
+		}
+		if archived {
+			return "", status.Errorf(codes.FailedPrecondition, "project is archived")
+		}
+	}
+	return s.db.GetProjectOwner(projectId)
+}
+
+// authorizeRead authorizes a reader of a project. The public jamsync project can be
+// read by anyone, including users that are not signed in.
+func (s JamsyncServer) authorizeRead(ctx context.Context, projectId uint64) (string, error) {
+	if projectId == 1 {
+		return s.db.GetProjectOwner(projectId)
+	}
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return "", err
+	}
+	return s.authorizeProject(projectId, userId, db.RoleReader)
+}
+
+func (s JamsyncServer) AddProjectMember(ctx context.Context, in *pb.AddProjectMemberRequest) (*pb.AddProjectMemberResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	role := db.Role(in.GetRole())
+	if role != db.RoleReader && role != db.RoleWriter {
+		return nil, status.Errorf(codes.InvalidArgument, "members can only be added as a reader or writer")
+	}
+
+	memberId, err := s.db.GetUserId(in.GetUsername())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", in.GetUsername())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if memberId == userId {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot change the role of the project owner")
+	}
+
+	err = s.db.AddProjectMember(in.GetProjectId(), memberId, role)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddProjectMemberResponse{}, nil
+}
+
+func (s JamsyncServer) RemoveProjectMember(ctx context.Context, in *pb.RemoveProjectMemberRequest) (*pb.RemoveProjectMemberResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberId, err := s.db.GetUserId(in.GetUsername())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", in.GetUsername())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Members may always remove themselves from a project.
+	required := db.RoleOwner
+	if memberId == userId {
+		required = db.RoleReader
+	}
+	owner, err := s.authorizeProject(in.GetProjectId(), userId, required)
+	if err != nil {
+		return nil, err
+	}
+	if memberId == owner {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot remove the project owner")
+	}
+
+	err = s.db.RemoveProjectMember(in.GetProjectId(), memberId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveProjectMemberResponse{}, nil
+}
+
+func (s JamsyncServer) ListProjectMembers(ctx context.Context, in *pb.ListProjectMembersRequest) (*pb.ListProjectMembersResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.db.ListProjectMembers(in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	membersPb := make([]*pb.ListProjectMembersResponse_Member, len(members))
+	for i := range membersPb {
+		membersPb[i] = &pb.ListProjectMembersResponse_Member{
+			Username: members[i].Username,
+			UserId:   members[i].UserId,
+			Role:     pb.ProjectRole(members[i].Role),
+		}
+	}
+	return &pb.ListProjectMembersResponse{Members: membersPb}, nil
+}
@@ -2,9 +2,18 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"strings"
 
 	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/jamignore"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/db"
 	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s JamsyncServer) AddProject(ctx context.Context, in *pb.AddProjectRequest) (*pb.AddProjectResponse, error) {
@@ -29,19 +38,126 @@ func (s JamsyncServer) ListUserProjects(ctx context.Context, in *pb.ListUserProj
 		return nil, err
 	}
 
-	projects, err := s.db.ListUserProjects(id)
+	projects, err := s.db.ListUserProjects(id, in.GetIncludeArchived())
 	if err != nil {
 		return nil, err
 	}
 
 	projectsPb := make([]*pb.ListUserProjectsResponse_Project, len(projects))
 	for i := range projectsPb {
-		projectsPb[i] = &pb.ListUserProjectsResponse_Project{Name: projects[i].Name, Id: projects[i].Id}
+		projectsPb[i] = &pb.ListUserProjectsResponse_Project{Name: projects[i].Name, Id: projects[i].Id, Archived: projects[i].Archived}
 	}
 
 	return &pb.ListUserProjectsResponse{Projects: projectsPb}, nil
 }
 
+func (s JamsyncServer) RenameProject(ctx context.Context, in *pb.RenameProjectRequest) (*pb.RenameProjectResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetProjectName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "project name is required")
+	}
+
+	err = s.db.RenameProject(in.GetProjectId(), in.GetProjectName())
+	if errors.Is(err, db.ErrProjectExists) {
+		return nil, status.Errorf(codes.AlreadyExists, "project %s already exists", in.GetProjectName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenameProjectResponse{}, nil
+}
+
+func (s JamsyncServer) ArchiveProject(ctx context.Context, in *pb.ArchiveProjectRequest) (*pb.ArchiveProjectResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.SetProjectArchived(in.GetProjectId(), in.GetArchived())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ArchiveProjectResponse{}, nil
+}
+
+func (s JamsyncServer) DeleteProject(ctx context.Context, in *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	// The project is archived so that nothing is written to it while its data is removed,
+	// and stays in the database until that succeeds. A failed delete can then be
+	// retried, and the id isn't given to a new project while the old data is there.
+	err = s.db.SetProjectArchived(in.GetProjectId(), true)
+	if err != nil {
+		return nil, err
+	}
+	err = s.deleteProjectData(in.GetProjectId(), ownerId)
+	if err != nil {
+		log.Printf("could not delete the data of project %d: %v", in.GetProjectId(), err)
+		return nil, err
+	}
+	err = s.db.DeleteProject(in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+// deleteProjectData releases the chunks that the operations of a project refer to and
+// removes its data from every store.
+func (s JamsyncServer) deleteProjectData(projectId uint64, ownerId string) error {
+	pathChanges, err := s.changestore.ListPathChanges(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	baselineChangeId, err := s.changestore.GetBaselineChange(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	for pathHash, changeIds := range pathChanges {
+		// Compaction writes operations for paths that the baseline change didn't touch.
+		if baselineChangeId != 0 {
+			changeIds = append(changeIds, baselineChangeId)
+		}
+		for _, changeId := range changeIds {
+			err = s.dropOperations(projectId, ownerId, pathHash, changeId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = s.opstore.DeleteProject(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	err = s.oplocstore.DeleteProject(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+	return s.changestore.DeleteProject(projectId, ownerId)
+}
+
 func (s JamsyncServer) ListProjects(ctx context.Context, in *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
 	projects, err := s.db.ListProjects()
 	if err != nil {
@@ -71,10 +187,50 @@ func (s JamsyncServer) GetProjectConfig(ctx context.Context, in *pb.GetProjectCo
 		}
 	}
 
-	changeId, _, err := s.changestore.GetCurrentChange(projectId, userId)
+	ownerId, err := s.authorizeProject(projectId, userId, db.RoleReader)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := in.GetBranch()
+	if branch == "" {
+		branch = changestore.MainBranch
+	}
+	changeId, err := s.changestore.GetBranchHead(projectId, ownerId, branch)
+	if err != nil {
+		return nil, branchError(branch, err)
+	}
+
+	ignorePatterns, err := s.db.GetProjectIgnorePatterns(projectId)
+	if errors.Is(err, sql.ErrNoRows) {
+		ignorePatterns = jamignore.DefaultProjectPatterns
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb.ProjectConfig{ProjectId: projectId, CurrentChange: changeId, IgnorePatterns: ignorePatterns, Branch: branch}, nil
+}
+
+func (s JamsyncServer) SetProjectIgnorePatterns(ctx context.Context, in *pb.SetProjectIgnorePatternsRequest) (*pb.SetProjectIgnorePatternsResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleOwner)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ProjectConfig{ProjectId: projectId, CurrentChange: changeId}, nil
+	for _, pattern := range in.GetIgnorePatterns() {
+		if strings.Contains(pattern, "\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "ignore patterns cannot contain newlines")
+		}
+	}
+
+	err = s.db.SetProjectIgnorePatterns(in.GetProjectId(), in.GetIgnorePatterns())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetProjectIgnorePatternsResponse{}, nil
 }
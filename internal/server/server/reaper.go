@@ -0,0 +1,71 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+)
+
+const (
+	// abandonedChangeAge is how long a change can stay open before it is assumed that
+	// its client went away and it is aborted.
+	abandonedChangeAge = 24 * time.Hour
+	reapInterval       = time.Hour
+)
+
+// runReaper aborts abandoned changes every interval until stop is closed.
+func (s JamsyncServer) runReaper(interval time.Duration, maxAge time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		err := s.reapChanges(time.Now().Add(-maxAge))
+		if err != nil {
+			log.Println("could not reap abandoned changes:", err)
+		}
+	}
+}
+
+// reapChanges aborts every open change created before cutoff.
+func (s JamsyncServer) reapChanges(cutoff time.Time) error {
+	projects, err := s.db.ListAllProjects()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		ownerId, err := s.db.GetProjectOwner(project.Id)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Deleted since it was listed.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		changes, err := s.changestore.ListOpenChanges(project.Id, ownerId)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if !change.Timestamp.Before(cutoff) {
+				continue
+			}
+			err = s.abortChange(project.Id, ownerId, change.Id)
+			// The change may have been committed since it was listed.
+			if err != nil && !errors.Is(err, changestore.ErrChangeNotOpen) {
+				return err
+			}
+			if err == nil {
+				log.Printf("aborted change %d of project %d, open since %s", change.Id, project.Id, change.Timestamp)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/rsync"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fileListPath is the file that holds the metadata of every other file in a project.
+const fileListPath = ".jamsyncfilelist"
+
+func (s JamsyncServer) RestoreFile(ctx context.Context, in *pb.RestoreFileRequest) (*pb.RestoreFileResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	restorePath := path.Clean(in.GetPath())
+	if restorePath == "." || restorePath == fileListPath || strings.HasPrefix(restorePath, "../") || strings.HasPrefix(restorePath, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid path %s", in.GetPath())
+	}
+
+	message := in.GetMessage()
+	if message == "" {
+		message = fmt.Sprintf("Restore %s to change %d", restorePath, in.GetChangeId())
+	}
+	changeId, err := s.restore(userId, in.GetProjectId(), ownerId, in.GetBranch(), in.GetChangeId(), message, func(filePath string) bool {
+		return filePath == restorePath || strings.HasPrefix(filePath, restorePath+"/")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreFileResponse{
+		ChangeId: changeId,
+	}, nil
+}
+
+func (s JamsyncServer) RevertChange(ctx context.Context, in *pb.RevertChangeRequest) (*pb.RevertChangeResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	message := in.GetMessage()
+	if message == "" {
+		message = fmt.Sprintf("Revert project to change %d", in.GetChangeId())
+	}
+	changeId, err := s.restore(userId, in.GetProjectId(), ownerId, in.GetBranch(), in.GetChangeId(), message, func(string) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevertChangeResponse{
+		ChangeId: changeId,
+	}, nil
+}
+
+// restore commits a new change to branch in which every path accepted by match has
+// the same content as it had at changeId. Matching paths that did not exist then are
+// deleted.
+func (s JamsyncServer) restore(userId string, projectId uint64, ownerId string, branch string, changeId uint64, message string, match func(filePath string) bool) (uint64, error) {
+	currentChangeId, err := s.changestore.GetBranchHead(projectId, ownerId, branch)
+	if err != nil {
+		return 0, branchError(branch, err)
+	}
+	if changeId == 0 || changeId > currentChangeId {
+		return 0, status.Errorf(codes.InvalidArgument, "change %d does not exist", changeId)
+	}
+
+	target, err := s.readFileList(projectId, ownerId, changeId)
+	if err != nil {
+		return 0, err
+	}
+	fileList, err := s.readFileList(projectId, ownerId, currentChangeId)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := make([]string, 0)
+	for filePath := range fileList.GetFiles() {
+		if _, found := target.GetFiles()[filePath]; match(filePath) && !found {
+			deleted = append(deleted, filePath)
+		}
+	}
+	restored := make([]string, 0)
+	for filePath, file := range target.GetFiles() {
+		if !match(filePath) {
+			continue
+		}
+		if sameFile(fileList.GetFiles()[filePath], file) {
+			continue
+		}
+		restored = append(restored, filePath)
+	}
+	if len(deleted) == 0 && len(restored) == 0 {
+		return 0, status.Errorf(codes.FailedPrecondition, "nothing to restore, files already match change %d", changeId)
+	}
+
+	return s.commitFiles(userId, projectId, ownerId, branch, message, currentChangeId, fileList, changeId, target, restored, deleted)
+}
+
+// sameFile reports whether two files in file lists have the same content and
+// metadata, apart from their modification times. A nil file is missing.
+func sameFile(a *pb.File, b *pb.File) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.GetDir() == b.GetDir() && a.GetHash() == b.GetHash() &&
+		a.GetMode() == b.GetMode() && a.GetSymlinkTarget() == b.GetSymlinkTarget()
+}
+
+// commitFiles commits a new change to branch on top of baseChangeId, whose file list
+// is fileList. The paths in copied get the content and metadata they have in the file
+// list source of sourceChangeId, and the paths in deleted are removed.
+func (s JamsyncServer) commitFiles(userId string, projectId uint64, ownerId string, branch string, message string, baseChangeId uint64, fileList *pb.FileMetadata, sourceChangeId uint64, source *pb.FileMetadata, copied []string, deleted []string) (changeId uint64, err error) {
+	// Basing the change on the file list it was computed from makes the commit fail
+	// rather than undo a change committed in the meantime.
+	newChangeId, err := s.changestore.AddChange(projectId, ownerId, branch, userId, message, "", &baseChangeId)
+	if err != nil {
+		return 0, branchError(branch, err)
+	}
+	// A change left open would hold back checkpoints and compaction until it is reaped.
+	defer func() {
+		if err == nil {
+			return
+		}
+		abortErr := s.abortChange(projectId, ownerId, newChangeId)
+		if abortErr != nil {
+			log.Printf("could not abort change %d of project %d: %v", newChangeId, projectId, abortErr)
+		}
+	}()
+
+	for _, filePath := range deleted {
+		delete(fileList.Files, filePath)
+	}
+	for _, filePath := range copied {
+		file := source.GetFiles()[filePath]
+		if !file.GetDir() && file.GetSymlinkTarget() == "" {
+			data, err := s.regenFile(projectId, ownerId, pathToHash(filePath), sourceChangeId)
+			if err != nil {
+				return 0, err
+			}
+			content, err := io.ReadAll(data)
+			if err != nil {
+				return 0, err
+			}
+			err = s.writeFile(projectId, ownerId, newChangeId, pathToHash(filePath), content)
+			if err != nil {
+				return 0, err
+			}
+		}
+		// A new modification time makes clients pick up the file even if they had an
+		// identical copy with a different time. Symlinks have no modification time.
+		copiedFile := proto.Clone(file).(*pb.File)
+		if file.GetSymlinkTarget() == "" {
+			copiedFile.ModTime = timestamppb.Now()
+		}
+		fileList.Files[filePath] = copiedFile
+	}
+
+	fileListData, err := proto.Marshal(fileList)
+	if err != nil {
+		return 0, err
+	}
+	err = s.writeFile(projectId, ownerId, newChangeId, pathToHash(fileListPath), fileListData)
+	if err != nil {
+		return 0, err
+	}
+
+	touched := append(append(copied, deleted...), fileListPath)
+	files := make([]changestore.ChangeFile, 0, len(touched))
+	for _, filePath := range touched {
+		files = append(files, changestore.ChangeFile{PathHash: pathToHash(filePath), Path: filePath})
+	}
+	err = s.changestore.CommitChange(projectId, ownerId, newChangeId, "", files, nil)
+	if err != nil {
+		return 0, changeStateError(newChangeId, err)
+	}
+
+	s.hub.Broadcast(&pb.ChangeStreamMessage{
+		ProjectId: projectId,
+		UserId:    userId,
+	})
+	return newChangeId, nil
+}
+
+func (s JamsyncServer) readFileList(projectId uint64, ownerId string, changeId uint64) (*pb.FileMetadata, error) {
+	data, err := s.regenFile(projectId, ownerId, pathToHash(fileListPath), changeId)
+	if err != nil {
+		return nil, err
+	}
+	return parseFileList(data)
+}
+
+// readOpenFileList reads the file list of changeId including its own upload when it
+// is still open.
+func (s JamsyncServer) readOpenFileList(projectId uint64, ownerId string, changeId uint64) (*pb.FileMetadata, error) {
+	data, err := s.regenOpenFile(projectId, ownerId, pathToHash(fileListPath), changeId)
+	if err != nil {
+		return nil, err
+	}
+	return parseFileList(data)
+}
+
+func parseFileList(data io.Reader) (*pb.FileMetadata, error) {
+	fileListData, err := io.ReadAll(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fileList := &pb.FileMetadata{}
+	err = proto.Unmarshal(fileListData, fileList)
+	if err != nil {
+		return nil, err
+	}
+	if fileList.Files == nil {
+		fileList.Files = make(map[string]*pb.File)
+	}
+	return fileList, nil
+}
+
+// writeFile stores data as the full content of a file in a change. It is written as a
+// delta against the content of the file before the change, like a client upload.
+func (s JamsyncServer) writeFile(projectId uint64, ownerId string, changeId uint64, pathHash uint64, data []byte) error {
+	current, err := s.regenBase(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+	opLocs, err := s.writeDelta(projectId, ownerId, changeId, pathHash, dataKey(pathHash, changeId), current, data)
+	if err != nil {
+		return err
+	}
+	return s.insertOperationLocations(projectId, ownerId, changeId, pathHash, opLocs)
+}
+
+// writeDelta writes the operations that turn current into data under key and returns
+// their locations. There is always at least one operation, so that applying them
+// replaces whatever content came before.
+func (s JamsyncServer) writeDelta(projectId uint64, ownerId string, changeId uint64, pathHash uint64, key uint64, current io.Reader, data []byte) ([]*pb.OperationLocations_OperationLocation, error) {
+	rs := rsync.RSync{UniqueHasher: xxhash.New()}
+	sig := make([]rsync.BlockHash, 0)
+	err := rs.CreateSignature(current, func(bl rsync.BlockHash) error {
+		sig = append(sig, bl)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	opLocs := make([]*pb.OperationLocations_OperationLocation, 0)
+	writeOp := func(op *pb.Operation) error {
+		op.ProjectId = projectId
+		op.ChangeId = changeId
+		op.PathHash = pathHash
+		opLoc, err := s.writeOperation(projectId, ownerId, key, op)
+		if err != nil {
+			return err
+		}
+		opLocs = append(opLocs, opLoc)
+		return nil
+	}
+	err = rs.CreateDelta(bytes.NewReader(data), sig, func(op rsync.Operation) error {
+		return writeOp(rsync.RsyncOperationToPb(op))
+	})
+	if err != nil {
+		return nil, err
+	}
+	// An empty file still needs an operation so that it replaces the old content.
+	if len(opLocs) == 0 {
+		err = writeOp(&pb.Operation{
+			Type: pb.Operation_OpData,
+			Data: []byte{},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return opLocs, nil
+}
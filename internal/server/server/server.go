@@ -11,6 +11,7 @@ import (
 	"github.com/zdgeier/jamsync/gen/pb"
 	"github.com/zdgeier/jamsync/internal/jamenv"
 	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/chunkstore"
 	"github.com/zdgeier/jamsync/internal/server/db"
 	"github.com/zdgeier/jamsync/internal/server/hub"
 	"github.com/zdgeier/jamsync/internal/server/oplocstore"
@@ -31,21 +32,63 @@ var devF embed.FS
 
 type JamsyncServer struct {
 	db          db.JamsyncDb
-	opstore     opstore.LocalStore
-	oplocstore  oplocstore.LocalOpLocStore
-	changestore changestore.LocalChangeStore
+	opstore     opstore.OpStore
+	oplocstore  oplocstore.OpLocStore
+	changestore changestore.ChangeStore
+	chunkstore  chunkstore.ChunkStore
 	hub         hub.Hub
+	uploads     *uploadSessions
 	pb.UnimplementedJamsyncAPIServer
 }
 
-func New() (closer func(), err error) {
+// Stores are the backends that a server keeps project data in.
+type Stores struct {
+	OpStore     opstore.OpStore
+	OpLocStore  oplocstore.OpLocStore
+	ChangeStore changestore.ChangeStore
+	ChunkStore  chunkstore.ChunkStore
+}
+
+// LocalStores returns stores that keep project data on disk below directory.
+func LocalStores(directory string) Stores {
+	return Stores{
+		OpStore:     opstore.NewLocalStore(directory),
+		OpLocStore:  oplocstore.NewLocalOpLocStore(directory),
+		ChangeStore: changestore.NewLocalChangeStore(directory),
+		ChunkStore:  chunkstore.NewLocalChunkStore(directory),
+	}
+}
+
+// MemoryStores returns stores that keep project data in memory.
+func MemoryStores() Stores {
+	return Stores{
+		OpStore:     opstore.NewMemoryStore(),
+		OpLocStore:  oplocstore.NewMemoryOpLocStore(),
+		ChangeStore: changestore.NewMemoryChangeStore(),
+		ChunkStore:  chunkstore.NewMemoryChunkStore(),
+	}
+}
+
+// NewJamsyncServer returns the API implementation without serving it, so that it can
+// be embedded or called directly.
+func NewJamsyncServer(database db.JamsyncDb, stores Stores) JamsyncServer {
 	jamsyncServer := JamsyncServer{
-		db:          db.New(),
-		opstore:     opstore.NewLocalStore("jb"),
-		oplocstore:  oplocstore.NewLocalOpLocStore("jb"),
-		changestore: changestore.NewLocalChangeStore(),
+		db:          database,
+		opstore:     stores.OpStore,
+		oplocstore:  stores.OpLocStore,
+		changestore: stores.ChangeStore,
+		chunkstore:  stores.ChunkStore,
 		hub:         *hub.NewHub(),
+		uploads:     newUploadSessions(),
 	}
+	go jamsyncServer.hub.Run()
+	return jamsyncServer
+}
+
+// New serves the API. Projects are compacted by retention unless it is the zero
+// policy. The background work started for the server is stopped by closer.
+func New(stores Stores, retention RetentionPolicy) (closer func(), err error) {
+	jamsyncServer := NewJamsyncServer(db.New(), stores)
 
 	var cert tls.Certificate
 	if jamenv.Env() == jamenv.Prod {
@@ -76,9 +119,15 @@ func New() (closer func(), err error) {
 		}
 	}()
 
-	go jamsyncServer.hub.Run()
-
-	return func() { server.Stop() }, nil
+	stop := make(chan struct{})
+	go jamsyncServer.runReaper(reapInterval, abandonedChangeAge, stop)
+	if retention.Enabled() {
+		go jamsyncServer.runCompactor(compactInterval, retention, stop)
+	}
+	return func() {
+		close(stop)
+		server.Stop()
+	}, nil
 }
 
 func Connect(accessToken *oauth2.Token) (client pb.JamsyncAPIClient, closer func(), err error) {
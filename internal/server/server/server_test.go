@@ -0,0 +1,1150 @@
+package server
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/cespare/xxhash"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/chunkstore"
+	"github.com/zdgeier/jamsync/internal/server/client"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/opstore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestServer serves a server backed by in-memory stores over an in-process
+// connection and returns a client for it along with a project owned by the local
+// test user.
+func newTestServer(t *testing.T) (pb.JamsyncAPIClient, uint64) {
+	return newTestServerWithStores(t, MemoryStores())
+}
+
+func newTestServerWithStores(t *testing.T, stores Stores) (pb.JamsyncAPIClient, uint64) {
+	t.Setenv("JAM_ENV", "local")
+
+	database := db.NewMemory()
+	projectId, err := database.AddProject("test", "test@jamsync.dev")
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := grpc.NewServer()
+	pb.RegisterJamsyncAPIServer(grpcServer, NewJamsyncServer(database, stores))
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewJamsyncAPIClient(conn), projectId
+}
+
+func TestServerMemoryStores(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	versions := [][]byte{
+		[]byte("hello"),
+		[]byte("hello world"),
+		[]byte(""),
+	}
+	c := client.NewClient(apiClient, projectId, 0)
+	for _, data := range versions {
+		require.NoError(t, c.CreateChange("edit"))
+		require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader(data)))
+		require.NoError(t, c.CommitChange())
+	}
+
+	for i, data := range versions {
+		result := new(bytes.Buffer)
+		versionClient := client.NewClient(apiClient, projectId, uint64(i+1))
+		require.NoError(t, versionClient.DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), result))
+		require.Equal(t, string(data), result.String())
+	}
+
+	change, err := apiClient.GetChange(ctx, &pb.GetChangeRequest{ProjectId: projectId, ChangeId: 2})
+	require.NoError(t, err)
+	require.Equal(t, "test@jamsync.dev", change.GetAuthorId())
+	require.Equal(t, "edit", change.GetMessage())
+	require.True(t, change.GetCommitted())
+	require.Len(t, change.GetFiles(), 1)
+	require.Equal(t, "a.txt", change.GetFiles()[0].GetPath())
+}
+
+func TestConcurrentWriteOperationStream(t *testing.T) {
+	stores := LocalStores(t.TempDir())
+	stores.OpStore = opstore.NewLocalStoreWithOptions(t.TempDir(), opstore.LocalStoreOptions{MaxOpenFiles: 2})
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	// Every stream writes the same two files in its own change, the way simultaneous
+	// pushes of the same files do.
+	const streams = 16
+	const opsPerStream = 50
+	var wg sync.WaitGroup
+	changeIds := make([]uint64, streams)
+	errs := make([]error, streams)
+	for i := 0; i < streams; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = func() error {
+				change, err := apiClient.CreateChange(ctx, &pb.CreateChangeRequest{ProjectId: projectId})
+				if err != nil {
+					return err
+				}
+				changeIds[i] = change.GetChangeId()
+
+				for pathHash := uint64(1); pathHash <= 2; pathHash++ {
+					stream, err := apiClient.WriteOperationStream(ctx)
+					if err != nil {
+						return err
+					}
+					for j := 0; j < opsPerStream; j++ {
+						err = stream.Send(&pb.Operation{
+							ProjectId: projectId,
+							ChangeId:  changeIds[i],
+							PathHash:  pathHash,
+							Type:      pb.Operation_OpData,
+							Data:      []byte(fmt.Sprintf("change %d file %d op %d", changeIds[i], pathHash, j)),
+						})
+						if err != nil {
+							return err
+						}
+					}
+					_, err = stream.CloseAndRecv()
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			}()
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	for _, changeId := range changeIds {
+		for pathHash := uint64(1); pathHash <= 2; pathHash++ {
+			opLocs, err := stores.OpLocStore.ListOperationLocations(projectId, "test@jamsync.dev", pathHash, changeId)
+			require.NoError(t, err)
+			require.Len(t, opLocs.GetOpLocs(), opsPerStream)
+			for j, loc := range opLocs.GetOpLocs() {
+				data, err := stores.OpStore.Read(projectId, "test@jamsync.dev", changeId, opLocs.GetDataKey(), loc.GetOffset(), loc.GetLength())
+				require.NoError(t, err)
+				op := new(pb.Operation)
+				require.NoError(t, proto.Unmarshal(data, op))
+				require.Equal(t, fmt.Sprintf("change %d file %d op %d", changeId, pathHash, j), string(op.GetData()))
+			}
+		}
+	}
+}
+
+func TestServerDeduplicatesData(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("vendored library\n"), 100)
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange("vendor"))
+	require.NoError(t, c.UploadFile(ctx, "a/lib.txt", bytes.NewReader(content)))
+	require.NoError(t, c.UploadFile(ctx, "b/lib.txt", bytes.NewReader(content)))
+	require.NoError(t, c.CommitChange())
+
+	refs, err := stores.ChunkStore.RefCount(chunkstore.Hash(content))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), refs)
+
+	for _, path := range []string{"a/lib.txt", "b/lib.txt"} {
+		result := new(bytes.Buffer)
+		require.NoError(t, c.DownloadFile(ctx, path, bytes.NewReader([]byte{}), result))
+		require.Equal(t, string(content), result.String())
+	}
+}
+
+func TestCopyFile(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	content := []byte("moved content")
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange("add"))
+	require.NoError(t, c.UploadFile(ctx, "src/a.txt", bytes.NewReader(content)))
+	require.NoError(t, c.CommitChange())
+
+	require.NoError(t, c.CreateChange("move"))
+	err := c.CopyFile(ctx, "src/a.txt", "lib/a.txt", xxhash.Sum64([]byte("other content")))
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, c.CopyFile(ctx, "src/a.txt", "lib/a.txt", xxhash.Sum64(content)))
+	require.NoError(t, c.CommitChange())
+
+	result := new(bytes.Buffer)
+	require.NoError(t, c.DownloadFile(ctx, "lib/a.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, string(content), result.String())
+
+	change, err := apiClient.GetChange(ctx, &pb.GetChangeRequest{ProjectId: projectId, ChangeId: 2})
+	require.NoError(t, err)
+	require.Len(t, change.GetFiles(), 1)
+	require.Equal(t, "lib/a.txt", change.GetFiles()[0].GetPath())
+}
+
+func TestResumeUpload(t *testing.T) {
+	apiClient, projectId := newTestServerWithStores(t, LocalStores(t.TempDir()))
+	ctx := context.Background()
+
+	change, err := apiClient.CreateChange(ctx, &pb.CreateChangeRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	parts := []string{"first ", "second ", "third ", "fourth"}
+	content := []byte("first second third fourth")
+	pathHash := pathToHash("a.txt")
+	dataOp := func(seq int) *pb.Operation {
+		return &pb.Operation{
+			ProjectId: projectId,
+			ChangeId:  change.GetChangeId(),
+			PathHash:  pathHash,
+			Type:      pb.Operation_OpData,
+			Data:      []byte(parts[seq]),
+			UploadId:  "upload",
+			Seq:       uint64(seq),
+		}
+	}
+	hashOp := func(hash uint64) *pb.Operation {
+		return &pb.Operation{
+			ProjectId:   projectId,
+			ChangeId:    change.GetChangeId(),
+			PathHash:    pathHash,
+			Type:        pb.Operation_OpHash,
+			UploadId:    "upload",
+			Seq:         uint64(len(parts)),
+			ContentHash: hash,
+		}
+	}
+
+	// Interrupt the upload after two operations.
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := apiClient.WriteOperationStream(streamCtx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(dataOp(0)))
+	require.NoError(t, stream.Send(dataOp(1)))
+	require.Eventually(t, func() bool {
+		resp, err := apiClient.GetUploadStatus(ctx, &pb.GetUploadStatusRequest{ProjectId: projectId, UploadId: "upload"})
+		return err == nil && resp.GetReceivedOps() == 2
+	}, time.Second, 10*time.Millisecond)
+	cancel()
+
+	// Skipping an operation is rejected, resending one is ignored.
+	// The server may not have noticed that the first stream is gone yet.
+	require.Eventually(t, func() bool {
+		stream, err := apiClient.WriteOperationStream(ctx)
+		if err != nil {
+			return false
+		}
+		stream.Send(dataOp(3))
+		_, err = stream.CloseAndRecv()
+		return status.Code(err) == codes.OutOfRange
+	}, time.Second, 10*time.Millisecond)
+	stream, err = apiClient.WriteOperationStream(ctx)
+	require.NoError(t, err)
+	for seq := 1; seq < len(parts); seq++ {
+		require.NoError(t, stream.Send(dataOp(seq)))
+	}
+	require.NoError(t, stream.Send(hashOp(xxhash.Sum64(content))))
+	_, err = stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	c := client.NewClient(apiClient, projectId, change.GetChangeId())
+	c.SetIncludeOpenChange(true)
+	result := new(bytes.Buffer)
+	require.NoError(t, c.DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, string(content), result.String())
+
+	// Content that doesn't match its hash is rejected and has to be uploaded again.
+	stream, err = apiClient.WriteOperationStream(ctx)
+	require.NoError(t, err)
+	for seq := range parts {
+		require.NoError(t, stream.Send(dataOp(seq)))
+	}
+	require.NoError(t, stream.Send(hashOp(xxhash.Sum64([]byte("something else")))))
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.DataLoss, status.Code(err))
+	resp, err := apiClient.GetUploadStatus(ctx, &pb.GetUploadStatusRequest{ProjectId: projectId, UploadId: "upload"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.GetReceivedOps())
+}
+
+func TestAbandonedUploadsReleaseChunks(t *testing.T) {
+	stores := LocalStores(t.TempDir())
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	change, err := apiClient.CreateChange(ctx, &pb.CreateChangeRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	content := bytes.Repeat([]byte("chunked "), minChunkSize)
+	pathHash := pathToHash("a.txt")
+	refs := func() uint64 {
+		refs, err := stores.ChunkStore.RefCount(chunkstore.Hash(content))
+		require.NoError(t, err)
+		return refs
+	}
+
+	// An upload whose content doesn't match its hash.
+	stream, err := apiClient.WriteOperationStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&pb.Operation{
+		ProjectId: projectId,
+		ChangeId:  change.GetChangeId(),
+		PathHash:  pathHash,
+		Type:      pb.Operation_OpData,
+		Data:      content,
+		UploadId:  "upload",
+	}))
+	require.NoError(t, stream.Send(&pb.Operation{
+		ProjectId:   projectId,
+		ChangeId:    change.GetChangeId(),
+		PathHash:    pathHash,
+		Type:        pb.Operation_OpHash,
+		UploadId:    "upload",
+		Seq:         1,
+		ContentHash: xxhash.Sum64String("something else"),
+	}))
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.DataLoss, status.Code(err))
+	require.Equal(t, uint64(0), refs())
+
+	// A stream that fails after writing an operation.
+	stream, err = apiClient.WriteOperationStream(ctx)
+	require.NoError(t, err)
+	op := &pb.Operation{
+		ProjectId: projectId,
+		ChangeId:  change.GetChangeId(),
+		PathHash:  pathHash,
+		Type:      pb.Operation_OpData,
+		Data:      content,
+	}
+	require.NoError(t, stream.Send(op))
+	require.NoError(t, stream.Send(&pb.Operation{ProjectId: projectId, ChangeId: change.GetChangeId() + 1, PathHash: pathHash}))
+	_, err = stream.CloseAndRecv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, uint64(0), refs())
+
+	// An upload that is never resumed.
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	ownerId := "test@jamsync.dev"
+	upload, err := s.uploads.acquire("expired", ownerId, projectId, ownerId, change.GetChangeId(), pathHash)
+	require.NoError(t, err)
+	opLoc, err := s.writeOperation(projectId, ownerId, dataKey(pathHash, change.GetChangeId()), op)
+	require.NoError(t, err)
+	s.uploads.add(upload, opLoc)
+	s.uploads.release(upload)
+	require.Equal(t, uint64(1), refs())
+	upload.updated = time.Now().Add(-2 * uploadSessionTTL)
+	s.expireUploads()
+	require.Equal(t, uint64(0), refs())
+}
+
+func TestUploadFileTwiceInChange(t *testing.T) {
+	stores := LocalStores(t.TempDir())
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	first := bytes.Repeat([]byte("long first version\n"), 50)
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange("add"))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader(first)))
+	refs, err := stores.ChunkStore.RefCount(chunkstore.Hash(first))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), refs)
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("short"))))
+	require.NoError(t, c.CommitChange())
+
+	// The chunks of the replaced upload are no longer referenced.
+	refs, err = stores.ChunkStore.RefCount(chunkstore.Hash(first))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), refs)
+
+	result := new(bytes.Buffer)
+	require.NoError(t, c.DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, "short", result.String())
+}
+
+func TestCommitChangeValidation(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	fileList, err := proto.Marshal(&pb.FileMetadata{
+		Files: map[string]*pb.File{
+			"a.txt": {Hash: xxhash.Sum64String("a")},
+			"dir":   {Dir: true},
+		},
+	})
+	require.NoError(t, err)
+
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileList)))
+	require.Equal(t, codes.FailedPrecondition, status.Code(c.CommitChange()))
+
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("a"))))
+	require.NoError(t, c.CommitChange())
+	require.Equal(t, codes.FailedPrecondition, status.Code(c.CommitChange()))
+
+	// Files whose content didn't change don't have to be uploaded again.
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileList)))
+	require.NoError(t, c.CommitChange())
+
+	_, err = apiClient.CommitChange(ctx, &pb.CommitChangeRequest{ProjectId: projectId, ChangeId: 99})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAbortChange(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("committed"))))
+	require.NoError(t, c.CommitChange())
+
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("aborted"))))
+	require.NoError(t, c.AbortChange())
+	require.Equal(t, codes.FailedPrecondition, status.Code(c.AbortChange()))
+	require.Equal(t, codes.FailedPrecondition, status.Code(c.CommitChange()))
+	require.Equal(t, codes.FailedPrecondition, status.Code(c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("late")))))
+
+	change, err := apiClient.GetChange(ctx, &pb.GetChangeRequest{ProjectId: projectId, ChangeId: 2})
+	require.NoError(t, err)
+	require.True(t, change.GetAborted())
+	require.False(t, change.GetCommitted())
+
+	result := new(bytes.Buffer)
+	require.NoError(t, client.NewClient(apiClient, projectId, 2).DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, "committed", result.String())
+}
+
+func TestReapChanges(t *testing.T) {
+	database := db.NewMemory()
+	projectId, err := database.AddProject("test", "test@jamsync.dev")
+	require.NoError(t, err)
+	s := NewJamsyncServer(database, MemoryStores())
+
+	for i := 0; i < 2; i++ {
+		_, err = s.changestore.AddChange(projectId, "test@jamsync.dev", changestore.MainBranch, "test@jamsync.dev", "", "", nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.changestore.CommitChange(projectId, "test@jamsync.dev", 1, "", nil, nil))
+
+	require.NoError(t, s.reapChanges(time.Now().Add(-time.Hour)))
+	open, err := s.changestore.ListOpenChanges(projectId, "test@jamsync.dev")
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+
+	require.NoError(t, s.reapChanges(time.Now().Add(time.Hour)))
+	open, err = s.changestore.ListOpenChanges(projectId, "test@jamsync.dev")
+	require.NoError(t, err)
+	require.Empty(t, open)
+
+	change, err := s.changestore.GetChange(projectId, "test@jamsync.dev", 1)
+	require.NoError(t, err)
+	require.True(t, change.Committed)
+	require.False(t, change.Aborted)
+}
+
+func TestBackgroundWorkStops(t *testing.T) {
+	s := NewJamsyncServer(db.NewMemory(), MemoryStores())
+	stop := make(chan struct{})
+	done := make(chan struct{}, 2)
+	go func() {
+		s.runReaper(time.Millisecond, time.Hour, stop)
+		done <- struct{}{}
+	}()
+	go func() {
+		s.runCompactor(time.Millisecond, RetentionPolicy{KeepChanges: 1}, stop)
+		done <- struct{}{}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	close(stop)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("background work kept running after it was stopped")
+		}
+	}
+}
+
+func TestCommitConflict(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("a"))))
+	require.NoError(t, c.CommitChange())
+
+	first := client.NewClient(apiClient, projectId, 1)
+	second := client.NewClient(apiClient, projectId, 1)
+	other := client.NewClient(apiClient, projectId, 1)
+	for _, c := range []*client.Client{first, second, other} {
+		require.NoError(t, c.CreateChange(""))
+	}
+	require.NoError(t, first.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("first"))))
+	require.NoError(t, second.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("second"))))
+	require.NoError(t, other.UploadFile(ctx, "b.txt", bytes.NewReader([]byte("b"))))
+	require.NoError(t, first.CommitChange())
+	require.NoError(t, other.CommitChange())
+
+	err := second.CommitChange()
+	require.Equal(t, codes.Aborted, status.Code(err))
+	conflict, ok := client.CommitConflict(err)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), conflict.GetBaseChangeId())
+	require.Equal(t, first.ProjectConfig().GetCurrentChange(), conflict.GetLatestChangeId())
+	require.Equal(t, []string{"a.txt"}, conflict.GetPaths())
+
+	// After moving past the conflicting change, the same edit goes through.
+	require.NoError(t, second.AbortChange())
+	second = client.NewClient(apiClient, projectId, other.ProjectConfig().GetCurrentChange())
+	require.NoError(t, second.CreateChange(""))
+	require.NoError(t, second.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("second"))))
+	require.NoError(t, second.CommitChange())
+}
+
+func TestCommitFilesAbortsOnError(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	ownerId := "test@jamsync.dev"
+
+	files := map[string]string{"a.txt": "one"}
+	c := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, c, files, "a.txt")
+	base := c.ProjectConfig().GetCurrentChange()
+	source, err := s.readFileList(projectId, ownerId, base)
+	require.NoError(t, err)
+	files["a.txt"] = "two"
+	pushFiles(t, c, files, "a.txt")
+
+	// Committing on top of a stale base conflicts, and the change it added is aborted
+	// rather than left open.
+	fileList, err := s.readFileList(projectId, ownerId, base)
+	require.NoError(t, err)
+	_, err = s.commitFiles(ownerId, projectId, ownerId, changestore.MainBranch, "", base, fileList, base, source, []string{"a.txt"}, nil)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	openChanges, err := stores.ChangeStore.ListOpenChanges(projectId, ownerId)
+	require.NoError(t, err)
+	require.Empty(t, openChanges)
+}
+
+func TestUpdateFileAbortsOnError(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	c := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, c, map[string]string{"a.txt": "one"}, "a.txt")
+	base := c.ProjectConfig().GetCurrentChange()
+
+	err := c.UpdateFile(ctx, "a.txt", iotest.ErrReader(errors.New("read failed")))
+	require.Error(t, err)
+	require.Equal(t, base, c.ProjectConfig().GetCurrentChange())
+	openChanges, err := stores.ChangeStore.ListOpenChanges(projectId, "test@jamsync.dev")
+	require.NoError(t, err)
+	require.Empty(t, openChanges)
+}
+
+func TestReadsSeeOnlyCommittedChanges(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+	download := func(c *client.Client, path string) string {
+		result := new(bytes.Buffer)
+		require.NoError(t, c.DownloadFile(ctx, path, bytes.NewReader([]byte{}), result))
+		return result.String()
+	}
+	currentChange := func() uint64 {
+		config, err := apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectId: projectId})
+		require.NoError(t, err)
+		return config.GetCurrentChange()
+	}
+
+	// Change 1 stays open while more changes than a checkpoint spans are committed.
+	open, err := apiClient.CreateChange(ctx, &pb.CreateChangeRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	openClient := client.NewClient(apiClient, projectId, open.GetChangeId())
+	require.NoError(t, openClient.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("open"))))
+
+	c := client.NewClient(apiClient, projectId, 0)
+	for i := 0; i < checkpointInterval+1; i++ {
+		require.NoError(t, c.CreateChange(""))
+		require.NoError(t, c.UploadFile(ctx, "b.txt", bytes.NewReader([]byte(fmt.Sprint(i)))))
+		require.NoError(t, c.CommitChange())
+	}
+	latest := c.ProjectConfig().GetCurrentChange()
+	require.Equal(t, latest, currentChange())
+
+	require.Equal(t, "", download(client.NewClient(apiClient, projectId, latest), "a.txt"))
+	openClient.SetIncludeOpenChange(true)
+	require.Equal(t, "open", download(openClient, "a.txt"))
+	// Only the author of an open change can read it.
+	other := client.NewClient(apiClient, projectId, 2)
+	other.SetIncludeOpenChange(true)
+	require.Error(t, other.DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), new(bytes.Buffer)))
+
+	// Nothing read while change 1 was open can be cached past it.
+	checkpointChangeId, _, err := stores.OpStore.ReadCheckpoint(projectId, "test@jamsync.dev", pathToHash("b.txt"), latest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), checkpointChangeId)
+
+	_, err = apiClient.CommitChange(ctx, &pb.CommitChangeRequest{ProjectId: projectId, ChangeId: open.GetChangeId()})
+	require.NoError(t, err)
+	require.Equal(t, "open", download(client.NewClient(apiClient, projectId, latest), "a.txt"))
+	require.Equal(t, fmt.Sprint(checkpointInterval), download(client.NewClient(apiClient, projectId, latest), "b.txt"))
+	checkpointChangeId, _, err = stores.OpStore.ReadCheckpoint(projectId, "test@jamsync.dev", pathToHash("b.txt"), latest)
+	require.NoError(t, err)
+	require.Equal(t, latest, checkpointChangeId)
+}
+
+func TestCompaction(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	ctx := context.Background()
+	ownerId := "test@jamsync.dev"
+
+	library := bytes.Repeat([]byte("vendored library\n"), 100)
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "lib.txt", bytes.NewReader(library)))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("one"))))
+	require.NoError(t, c.CommitChange())
+	for _, data := range []string{"two", "three", "four"} {
+		require.NoError(t, c.CreateChange(""))
+		require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte(data))))
+		require.NoError(t, c.CommitChange())
+	}
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("aborted"))))
+	require.NoError(t, c.AbortChange())
+	_, err := stores.OpStore.Read(projectId, ownerId, 5, dataKey(pathToHash("a.txt"), 5), 0, 1)
+	require.Error(t, err)
+
+	baseline, err := s.compactProject(projectId, ownerId, RetentionPolicy{KeepChanges: 1}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), baseline)
+	baseline, err = s.compactProject(projectId, ownerId, RetentionPolicy{KeepChanges: 1}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), baseline)
+
+	download := func(changeId uint64, path string) (string, error) {
+		result := new(bytes.Buffer)
+		err := client.NewClient(apiClient, projectId, changeId).DownloadFile(ctx, path, bytes.NewReader([]byte{}), result)
+		return result.String(), err
+	}
+	for changeId, expected := range map[uint64]string{3: "three", 4: "four"} {
+		data, err := download(changeId, "a.txt")
+		require.NoError(t, err)
+		require.Equal(t, expected, data)
+	}
+	data, err := download(4, "lib.txt")
+	require.NoError(t, err)
+	require.Equal(t, string(library), data)
+	_, err = download(2, "a.txt")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The operations of the changes before the baseline are gone, and the baseline
+	// holds the only reference to data they shared.
+	for changeId := uint64(1); changeId < 3; changeId++ {
+		opLocs, err := stores.OpLocStore.ListOperationLocations(projectId, ownerId, pathToHash("a.txt"), changeId)
+		require.NoError(t, err)
+		require.Nil(t, opLocs)
+		_, err = stores.OpStore.Read(projectId, ownerId, changeId, dataKey(pathToHash("a.txt"), changeId), 0, 1)
+		require.Error(t, err)
+	}
+	refs, err := stores.ChunkStore.RefCount(chunkstore.Hash(library))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), refs)
+
+	// Once every change is old enough, only the latest is kept.
+	baseline, err = s.compactProject(projectId, ownerId, RetentionPolicy{KeepDuration: time.Hour}, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), baseline)
+	data, err = download(4, "a.txt")
+	require.NoError(t, err)
+	require.Equal(t, "four", data)
+
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "a.txt", bytes.NewReader([]byte("five"))))
+	require.NoError(t, c.CommitChange())
+	data, err = download(6, "a.txt")
+	require.NoError(t, err)
+	require.Equal(t, "five", data)
+}
+
+func TestCompactionMovesSharedOperations(t *testing.T) {
+	stores := MemoryStores()
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	projectId, ownerId, pathHash := uint64(1), "test@jamsync.dev", pathToHash("a.txt")
+
+	// Operations used to be written for every change of a path under its hash.
+	for _, data := range []string{"one", "two", "three"} {
+		changeId, err := s.changestore.AddChange(projectId, ownerId, changestore.MainBranch, ownerId, "", "", nil)
+		require.NoError(t, err)
+		opLoc, err := s.writeOperation(projectId, ownerId, pathHash, &pb.Operation{
+			ProjectId: projectId,
+			ChangeId:  changeId,
+			PathHash:  pathHash,
+			Type:      pb.Operation_OpData,
+			Data:      []byte(data),
+		})
+		require.NoError(t, err)
+		require.NoError(t, stores.OpLocStore.InsertOperationLocations(&pb.OperationLocations{
+			ProjectId: projectId,
+			OwnerId:   ownerId,
+			ChangeId:  changeId,
+			PathHash:  pathHash,
+			OpLocs:    []*pb.OperationLocations_OperationLocation{opLoc},
+		}))
+		require.NoError(t, s.changestore.CommitChange(projectId, ownerId, changeId, "", []changestore.ChangeFile{{PathHash: pathHash}}, nil))
+	}
+
+	baseline, err := s.compactProject(projectId, ownerId, RetentionPolicy{KeepChanges: 1}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), baseline)
+
+	_, err = stores.OpStore.Read(projectId, ownerId, 1, pathHash, 0, 1)
+	require.Error(t, err)
+	opLocs, err := stores.OpLocStore.ListOperationLocations(projectId, ownerId, pathHash, 3)
+	require.NoError(t, err)
+	require.Equal(t, dataKey(pathHash, 3), opLocs.GetDataKey())
+	for changeId, expected := range map[uint64]string{2: "two", 3: "three"} {
+		data, err := s.regenFile(projectId, ownerId, pathHash, changeId)
+		require.NoError(t, err)
+		require.Equal(t, expected, readAll(t, data))
+	}
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	directory := t.TempDir()
+	stores := LocalStores(directory)
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	library := bytes.Repeat([]byte("vendored library\n"), 100)
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	require.NoError(t, c.UploadFile(ctx, "lib.txt", bytes.NewReader(library)))
+	require.NoError(t, c.CommitChange())
+
+	_, err := apiClient.RenameProject(ctx, &pb.RenameProjectRequest{ProjectId: projectId, ProjectName: "renamed"})
+	require.NoError(t, err)
+	project, err := apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectName: "renamed"})
+	require.NoError(t, err)
+	require.Equal(t, projectId, project.GetProjectId())
+
+	_, err = apiClient.ArchiveProject(ctx, &pb.ArchiveProjectRequest{ProjectId: projectId, Archived: true})
+	require.NoError(t, err)
+	err = c.CreateChange("")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	projects, err := apiClient.ListUserProjects(ctx, &pb.ListUserProjectsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, projects.GetProjects())
+	projects, err = apiClient.ListUserProjects(ctx, &pb.ListUserProjectsRequest{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, projects.GetProjects(), 1)
+	require.True(t, projects.GetProjects()[0].GetArchived())
+	result := new(bytes.Buffer)
+	require.NoError(t, c.DownloadFile(ctx, "lib.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, string(library), result.String())
+
+	_, err = apiClient.DeleteProject(ctx, &pb.DeleteProjectRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	_, err = apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectName: "renamed"})
+	require.Error(t, err)
+	refs, err := stores.ChunkStore.RefCount(chunkstore.Hash(library))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), refs)
+	_, err = os.Stat(fmt.Sprintf("%s/test@jamsync.dev/%d", directory, projectId))
+	require.True(t, os.IsNotExist(err))
+}
+
+// failingOpStore fails to delete projects while fail is set.
+type failingOpStore struct {
+	opstore.OpStore
+	fail *bool
+}
+
+func (s failingOpStore) DeleteProject(projectId uint64, ownerId string) error {
+	if *s.fail {
+		return errors.New("op store is unavailable")
+	}
+	return s.OpStore.DeleteProject(projectId, ownerId)
+}
+
+func TestDeleteProjectFailure(t *testing.T) {
+	directory := t.TempDir()
+	stores := LocalStores(directory)
+	fail := true
+	stores.OpStore = failingOpStore{stores.OpStore, &fail}
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	ctx := context.Background()
+
+	c := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, c, map[string]string{"a.txt": "one"}, "a.txt")
+
+	// A project whose data could not be removed is kept, archived, so that deleting it
+	// can be retried and its id isn't reused while its data is there.
+	_, err := apiClient.DeleteProject(ctx, &pb.DeleteProjectRequest{ProjectId: projectId})
+	require.Error(t, err)
+	projects, err := apiClient.ListUserProjects(ctx, &pb.ListUserProjectsRequest{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, projects.GetProjects(), 1)
+	require.True(t, projects.GetProjects()[0].GetArchived())
+	added, err := apiClient.AddProject(ctx, &pb.AddProjectRequest{ProjectName: "other"})
+	require.NoError(t, err)
+	require.NotEqual(t, projectId, added.GetProjectId())
+
+	fail = false
+	_, err = apiClient.DeleteProject(ctx, &pb.DeleteProjectRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	_, err = os.Stat(fmt.Sprintf("%s/test@jamsync.dev/%d", directory, projectId))
+	require.True(t, os.IsNotExist(err))
+	_, err = apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectId: projectId})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBranches(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	ctx := context.Background()
+
+	mainFiles := map[string]string{"a.txt": "one", "b.txt": "base", "c.txt": "c0"}
+	mainClient := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, mainClient, mainFiles, "a.txt", "b.txt", "c.txt")
+
+	branch, err := apiClient.CreateBranch(ctx, &pb.CreateBranchRequest{ProjectId: projectId, Name: "feature"})
+	require.NoError(t, err)
+	require.Equal(t, "main", branch.GetParent())
+	require.Equal(t, uint64(1), branch.GetForkChangeId())
+	_, err = apiClient.CreateBranch(ctx, &pb.CreateBranchRequest{ProjectId: projectId, Name: "feature"})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	branchFiles := map[string]string{"a.txt": "branch", "b.txt": "base", "c.txt": "c0"}
+	branchClient := client.NewClient(apiClient, projectId, branch.GetHeadChangeId())
+	branchClient.SetBranch("feature")
+	pushFiles(t, branchClient, branchFiles, "a.txt")
+
+	// Main keeps changing c.txt, enough to be checkpointed, without the branch seeing
+	// any of it.
+	mainFiles["b.txt"] = "main"
+	pushFiles(t, mainClient, mainFiles, "b.txt")
+	for i := 0; i <= checkpointInterval; i++ {
+		mainFiles["c.txt"] = fmt.Sprint("c", i+1)
+		pushFiles(t, mainClient, mainFiles, "c.txt")
+	}
+	download := func(changeId uint64, path string) string {
+		result := new(bytes.Buffer)
+		require.NoError(t, client.NewClient(apiClient, projectId, changeId).DownloadFile(ctx, path, bytes.NewReader([]byte{}), result))
+		return result.String()
+	}
+	mainHead := mainClient.ProjectConfig().GetCurrentChange()
+	branchHead := branchClient.ProjectConfig().GetCurrentChange()
+	for path, content := range mainFiles {
+		require.Equal(t, content, download(mainHead, path))
+	}
+	for path, content := range branchFiles {
+		require.Equal(t, content, download(branchHead, path))
+	}
+
+	config, err := apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectId: projectId, Branch: "feature"})
+	require.NoError(t, err)
+	require.Equal(t, branchHead, config.GetCurrentChange())
+	config, err = apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	require.Equal(t, mainHead, config.GetCurrentChange())
+	_, err = apiClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{ProjectId: projectId, Branch: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	merge, err := apiClient.MergeBranch(ctx, &pb.MergeBranchRequest{ProjectId: projectId, Name: "feature"})
+	require.NoError(t, err)
+	require.Greater(t, merge.GetChangeId(), mainHead)
+	mainFiles["a.txt"] = "branch"
+	for path, content := range mainFiles {
+		require.Equal(t, content, download(merge.GetChangeId(), path))
+	}
+	_, err = apiClient.MergeBranch(ctx, &pb.MergeBranchRequest{ProjectId: projectId, Name: "feature"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The branch changing b.txt after main did since the merge base is a conflict.
+	branchFiles["b.txt"] = "branch"
+	pushFiles(t, branchClient, branchFiles, "b.txt")
+	_, err = apiClient.MergeBranch(ctx, &pb.MergeBranchRequest{ProjectId: projectId, Name: "feature"})
+	conflict, ok := client.CommitConflict(err)
+	require.True(t, ok)
+	require.Equal(t, []string{"b.txt"}, conflict.GetPaths())
+	// Checkpoints of main before the latest branch change are not in its ancestry.
+	for path, content := range branchFiles {
+		require.Equal(t, content, download(branchClient.ProjectConfig().GetCurrentChange(), path))
+	}
+
+	branches, err := apiClient.ListBranches(ctx, &pb.ListBranchesRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	require.Len(t, branches.GetBranches(), 2)
+	require.Equal(t, "main", branches.GetBranches()[0].GetName())
+	require.Equal(t, merge.GetChangeId(), branches.GetBranches()[0].GetHeadChangeId())
+	require.Equal(t, branchHead, branches.GetBranches()[1].GetMergeBaseChangeId())
+	require.NotNil(t, branches.GetBranches()[0].GetTimestamp())
+
+	// Without a branch, only the history of main is listed.
+	mainCommits, err := apiClient.ListCommittedChanges(ctx, &pb.ListCommittedChangesRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	require.NotContains(t, mainCommits.GetChangeIds(), branchHead)
+	require.Equal(t, merge.GetChangeId(), mainCommits.GetChangeIds()[len(mainCommits.GetChangeIds())-1])
+	branchCommits, err := apiClient.ListCommittedChanges(ctx, &pb.ListCommittedChangesRequest{ProjectId: projectId, Branch: "feature"})
+	require.NoError(t, err)
+	require.Contains(t, branchCommits.GetChangeIds(), branchHead)
+
+	// Compaction keeps main up to where the branch was forked.
+	baseline, err := s.compactProject(projectId, "test@jamsync.dev", RetentionPolicy{KeepChanges: 1}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, branch.GetForkChangeId(), baseline)
+	for path, content := range branchFiles {
+		require.Equal(t, content, download(branchClient.ProjectConfig().GetCurrentChange(), path))
+	}
+}
+
+func TestDiffFile(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	mainClient := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, mainClient, map[string]string{"a.txt": "one\n"}, "a.txt")
+	base := mainClient.ProjectConfig().GetCurrentChange()
+	branch, err := apiClient.CreateBranch(ctx, &pb.CreateBranchRequest{ProjectId: projectId, Name: "feature"})
+	require.NoError(t, err)
+	branchClient := client.NewClient(apiClient, projectId, branch.GetHeadChangeId())
+	branchClient.SetBranch("feature")
+	pushFiles(t, branchClient, map[string]string{"a.txt": "branch\n"}, "a.txt")
+	pushFiles(t, mainClient, map[string]string{"a.txt": "main\n"}, "a.txt")
+
+	// A to_change of 0 compares against the head of the requested branch.
+	resp, err := apiClient.DiffFile(ctx, &pb.DiffFileRequest{ProjectId: projectId, Path: "a.txt", FromChange: base, Branch: "feature"})
+	require.NoError(t, err)
+	require.True(t, resp.GetChanged())
+	require.Contains(t, resp.GetDiff(), "+branch")
+	resp, err = apiClient.DiffFile(ctx, &pb.DiffFileRequest{ProjectId: projectId, Path: "a.txt", FromChange: base})
+	require.NoError(t, err)
+	require.Contains(t, resp.GetDiff(), "+main")
+	_, err = apiClient.DiffFile(ctx, &pb.DiffFileRequest{ProjectId: projectId, Path: "a.txt", FromChange: base, Branch: "missing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Symbolic links are compared by their targets.
+	pushLink := func(target string) uint64 {
+		fileList := &pb.FileMetadata{Files: map[string]*pb.File{
+			"a.txt": {Hash: xxhash.Sum64String("main\n")},
+			"link":  {SymlinkTarget: target, Hash: xxhash.Sum64String(target)},
+		}}
+		fileListData, err := proto.Marshal(fileList)
+		require.NoError(t, err)
+		require.NoError(t, mainClient.CreateChange(""))
+		require.NoError(t, mainClient.UploadFile(ctx, "link", bytes.NewReader([]byte(target))))
+		require.NoError(t, mainClient.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileListData)))
+		require.NoError(t, mainClient.CommitChange())
+		return mainClient.ProjectConfig().GetCurrentChange()
+	}
+	first := pushLink("a.txt")
+	second := pushLink("b.txt")
+	resp, err = apiClient.DiffFile(ctx, &pb.DiffFileRequest{ProjectId: projectId, Path: "link", FromChange: first, ToChange: second})
+	require.NoError(t, err)
+	require.True(t, resp.GetChanged())
+	require.True(t, resp.GetSymlink())
+	require.Equal(t, "link changed from a symbolic link to a.txt to a symbolic link to b.txt\n", resp.GetDiff())
+	resp, err = apiClient.DiffFile(ctx, &pb.DiffFileRequest{ProjectId: projectId, Path: "link", FromChange: second, ToChange: second})
+	require.NoError(t, err)
+	require.False(t, resp.GetChanged())
+}
+
+// pushFiles commits a change that uploads the paths in changed along with a file list
+// of every file in files.
+func pushFiles(t *testing.T, c *client.Client, files map[string]string, changed ...string) {
+	ctx := context.Background()
+	fileList := &pb.FileMetadata{Files: make(map[string]*pb.File)}
+	for path, content := range files {
+		fileList.Files[path] = &pb.File{Hash: xxhash.Sum64String(content)}
+	}
+	fileListData, err := proto.Marshal(fileList)
+	require.NoError(t, err)
+
+	require.NoError(t, c.CreateChange(""))
+	for _, path := range changed {
+		require.NoError(t, c.UploadFile(ctx, path, bytes.NewReader([]byte(files[path]))))
+	}
+	require.NoError(t, c.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileListData)))
+	require.NoError(t, c.CommitChange())
+}
+
+func readAll(t *testing.T, r io.Reader) string {
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestTags(t *testing.T) {
+	stores := MemoryStores()
+	apiClient, projectId := newTestServerWithStores(t, stores)
+	s := NewJamsyncServer(db.NewMemory(), stores)
+	ctx := context.Background()
+
+	files := map[string]string{"a.txt": "one"}
+	c := client.NewClient(apiClient, projectId, 0)
+	pushFiles(t, c, files, "a.txt")
+	release := c.ProjectConfig().GetCurrentChange()
+
+	tag, err := apiClient.CreateTag(ctx, &pb.CreateTagRequest{ProjectId: projectId, Name: "v1", Message: "first release"})
+	require.NoError(t, err)
+	require.Equal(t, release, tag.GetChangeId())
+	require.Equal(t, "first release", tag.GetMessage())
+	_, err = apiClient.CreateTag(ctx, &pb.CreateTagRequest{ProjectId: projectId, Name: "v1", ChangeId: release})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = apiClient.CreateTag(ctx, &pb.CreateTagRequest{ProjectId: projectId, Name: "12", ChangeId: release})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.NoError(t, c.CreateChange(""))
+	_, err = apiClient.CreateTag(ctx, &pb.CreateTagRequest{ProjectId: projectId, Name: "open", ChangeId: c.ProjectConfig().GetCurrentChange()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.NoError(t, c.AbortChange())
+
+	for _, content := range []string{"two", "three"} {
+		files["a.txt"] = content
+		pushFiles(t, c, files, "a.txt")
+	}
+
+	got, err := apiClient.GetTag(ctx, &pb.GetTagRequest{ProjectId: projectId, Name: "v1"})
+	require.NoError(t, err)
+	require.Equal(t, release, got.GetChangeId())
+	_, err = apiClient.GetTag(ctx, &pb.GetTagRequest{ProjectId: projectId, Name: "v2"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Compaction keeps the tagged change readable.
+	baseline, err := s.compactProject(projectId, "test@jamsync.dev", RetentionPolicy{KeepChanges: 1}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, release, baseline)
+	result := new(bytes.Buffer)
+	require.NoError(t, client.NewClient(apiClient, projectId, got.GetChangeId()).DownloadFile(ctx, "a.txt", bytes.NewReader([]byte{}), result))
+	require.Equal(t, "one", result.String())
+
+	_, err = apiClient.DeleteTag(ctx, &pb.DeleteTagRequest{ProjectId: projectId, Name: "v1"})
+	require.NoError(t, err)
+	tags, err := apiClient.ListTags(ctx, &pb.ListTagsRequest{ProjectId: projectId})
+	require.NoError(t, err)
+	require.Empty(t, tags.GetTags())
+}
+
+func TestExportSnapshot(t *testing.T) {
+	apiClient, projectId := newTestServer(t)
+	ctx := context.Background()
+
+	modTime := time.Date(2022, 5, 1, 12, 30, 0, 0, time.UTC)
+	contents := map[string]string{"README.md": "readme", "src/main.go": "package main"}
+	fileList := &pb.FileMetadata{Files: map[string]*pb.File{
+		"README.md":   {ModTime: timestamppb.New(modTime), Hash: xxhash.Sum64String("readme"), Mode: 0o644},
+		"src":         {ModTime: timestamppb.New(modTime), Dir: true, Mode: 0o755},
+		"src/main.go": {ModTime: timestamppb.New(modTime.Add(time.Hour)), Hash: xxhash.Sum64String("package main"), Mode: 0o755},
+		"docs":        {SymlinkTarget: "README.md", Hash: xxhash.Sum64String("README.md")},
+	}}
+	fileListData, err := proto.Marshal(fileList)
+	require.NoError(t, err)
+	c := client.NewClient(apiClient, projectId, 0)
+	require.NoError(t, c.CreateChange(""))
+	for path, content := range contents {
+		require.NoError(t, c.UploadFile(ctx, path, bytes.NewReader([]byte(content))))
+	}
+	require.NoError(t, c.UploadFile(ctx, ".jamsyncfilelist", bytes.NewReader(fileListData)))
+	require.NoError(t, c.CommitChange())
+
+	archive := new(bytes.Buffer)
+	require.NoError(t, c.ExportSnapshot(ctx, pb.ExportSnapshotRequest_TarGz, archive))
+	gz, err := gzip.NewReader(archive)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	names := make([]string, 0)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, header.Name)
+		switch header.Name {
+		case "docs":
+			require.Equal(t, byte(tar.TypeSymlink), header.Typeflag)
+			require.Equal(t, "README.md", header.Linkname)
+		case "src/":
+			require.Equal(t, byte(tar.TypeDir), header.Typeflag)
+			require.True(t, modTime.Equal(header.ModTime))
+		default:
+			file := fileList.GetFiles()[header.Name]
+			require.Equal(t, contents[header.Name], readAll(t, tr))
+			require.True(t, file.GetModTime().AsTime().Equal(header.ModTime))
+			require.Equal(t, int64(file.GetMode()), header.Mode)
+		}
+	}
+	require.Equal(t, []string{"README.md", "docs", "src/", "src/main.go"}, names)
+
+	archive.Reset()
+	require.NoError(t, c.ExportSnapshot(ctx, pb.ExportSnapshotRequest_Zip, archive))
+	zr, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	require.NoError(t, err)
+	require.Len(t, zr.File, 4)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content := readAll(t, r)
+		r.Close()
+		switch f.Name {
+		case "docs":
+			require.Equal(t, fs.ModeSymlink, f.Mode()&fs.ModeSymlink)
+			require.Equal(t, "README.md", content)
+		case "src/":
+			require.True(t, f.Mode().IsDir())
+		default:
+			require.Equal(t, contents[f.Name], content)
+			require.True(t, fileList.GetFiles()[f.Name].GetModTime().AsTime().Equal(f.Modified))
+			require.Equal(t, fs.FileMode(fileList.GetFiles()[f.Name].GetMode()), f.Mode().Perm())
+		}
+	}
+}
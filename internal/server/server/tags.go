@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/changestore"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s JamsyncServer) CreateTag(ctx context.Context, in *pb.CreateTagRequest) (*pb.TagInfo, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+	if in.GetName() == "" || strings.ContainsAny(in.GetName(), " \t\r\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "tag names can't be empty or contain whitespace")
+	}
+	if _, err := strconv.ParseUint(in.GetName(), 10, 64); err == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag names can't be numbers, since they would be mistaken for change ids")
+	}
+
+	changeId := in.GetChangeId()
+	if changeId == 0 {
+		changeId, err = s.changestore.GetLatestCommittedChange(in.GetProjectId(), ownerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	baselineChangeId, err := s.changestore.GetBaselineChange(in.GetProjectId(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+	if changeId < baselineChangeId {
+		return nil, status.Errorf(codes.FailedPrecondition, "change %d was compacted, the oldest change that can be tagged is %d", changeId, baselineChangeId)
+	}
+
+	tag, err := s.changestore.CreateTag(in.GetProjectId(), ownerId, in.GetName(), changeId, userId, in.GetMessage())
+	if err != nil {
+		if errors.Is(err, changestore.ErrTagExists) {
+			return nil, tagError(in.GetName(), err)
+		}
+		return nil, changeStateError(changeId, err)
+	}
+	return tagToPb(tag), nil
+}
+
+func (s JamsyncServer) GetTag(ctx context.Context, in *pb.GetTagRequest) (*pb.TagInfo, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.changestore.GetTag(in.GetProjectId(), ownerId, in.GetName())
+	if err != nil {
+		return nil, tagError(in.GetName(), err)
+	}
+	return tagToPb(tag), nil
+}
+
+func (s JamsyncServer) ListTags(ctx context.Context, in *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	ownerId, err := s.authorizeRead(ctx, in.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.changestore.ListTags(in.GetProjectId(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+	pbTags := make([]*pb.TagInfo, 0, len(tags))
+	for _, tag := range tags {
+		pbTags = append(pbTags, tagToPb(tag))
+	}
+	return &pb.ListTagsResponse{
+		Tags: pbTags,
+	}, nil
+}
+
+func (s JamsyncServer) DeleteTag(ctx context.Context, in *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerId, err := s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changestore.DeleteTag(in.GetProjectId(), ownerId, in.GetName())
+	if err != nil {
+		return nil, tagError(in.GetName(), err)
+	}
+	return &pb.DeleteTagResponse{}, nil
+}
+
+// tagError turns the errors of the changestore about tags into statuses.
+func tagError(tag string, err error) error {
+	switch {
+	case errors.Is(err, changestore.ErrTagNotFound):
+		return status.Errorf(codes.NotFound, "tag %s not found", tag)
+	case errors.Is(err, changestore.ErrTagExists):
+		return status.Errorf(codes.AlreadyExists, "tag %s already exists", tag)
+	}
+	return err
+}
+
+func tagToPb(tag changestore.Tag) *pb.TagInfo {
+	return &pb.TagInfo{
+		Name:      tag.Name,
+		ChangeId:  tag.ChangeId,
+		Message:   tag.Message,
+		AuthorId:  tag.AuthorId,
+		Timestamp: timestamppb.New(tag.Timestamp),
+	}
+}
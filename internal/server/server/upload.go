@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash"
+	"github.com/zdgeier/jamsync/gen/pb"
+	"github.com/zdgeier/jamsync/internal/server/db"
+	"github.com/zdgeier/jamsync/internal/server/serverauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadSessionTTL is how long an interrupted upload can be resumed for.
+const uploadSessionTTL = time.Hour
+
+// uploadSession is a file upload that has not been accepted yet. The operations it has
+// received are already in the op store, so a resumed upload only sends the rest.
+type uploadSession struct {
+	id        string
+	userId    string
+	projectId uint64
+	ownerId   string
+	changeId  uint64
+	pathHash  uint64
+
+	// The fields below are guarded by the uploadSessions mutex.
+	opLocs  []*pb.OperationLocations_OperationLocation
+	active  bool
+	updated time.Time
+}
+
+// uploadSessions are the uploads in progress on this server. They are kept in memory,
+// so an upload resumed on another server starts over.
+type uploadSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*uploadSession
+}
+
+func newUploadSessions() *uploadSessions {
+	return &uploadSessions{
+		sessions: make(map[string]*uploadSession),
+	}
+}
+
+// acquire returns the session for an upload, creating it if needed. Only one stream
+// can write to an upload at a time, and it must call release when it is done.
+func (u *uploadSessions) acquire(id string, userId string, projectId uint64, ownerId string, changeId uint64, pathHash uint64) (*uploadSession, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := time.Now()
+	session, found := u.sessions[id]
+	if !found {
+		session = &uploadSession{
+			id:        id,
+			userId:    userId,
+			projectId: projectId,
+			ownerId:   ownerId,
+			changeId:  changeId,
+			pathHash:  pathHash,
+		}
+		u.sessions[id] = session
+	}
+	if session.userId != userId || session.projectId != projectId {
+		return nil, status.Errorf(codes.PermissionDenied, "unauthorized")
+	}
+	if session.active {
+		return nil, status.Errorf(codes.Aborted, "upload %s is already in progress", id)
+	}
+	session.active = true
+	session.updated = now
+	return session, nil
+}
+
+// expire removes the uploads that were not resumed within uploadSessionTTL of now and
+// returns them.
+func (u *uploadSessions) expire(now time.Time) []*uploadSession {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	expired := make([]*uploadSession, 0)
+	for sessionId, session := range u.sessions {
+		if !session.active && now.Sub(session.updated) > uploadSessionTTL {
+			delete(u.sessions, sessionId)
+			expired = append(expired, session)
+		}
+	}
+	return expired
+}
+
+func (u *uploadSessions) release(session *uploadSession) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	session.active = false
+	session.updated = time.Now()
+}
+
+func (u *uploadSessions) remove(session *uploadSession) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.sessions[session.id] == session {
+		delete(u.sessions, session.id)
+	}
+}
+
+func (u *uploadSessions) add(session *uploadSession, opLoc *pb.OperationLocations_OperationLocation) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	session.opLocs = append(session.opLocs, opLoc)
+}
+
+func (u *uploadSessions) received(session *uploadSession) uint64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return uint64(len(session.opLocs))
+}
+
+func (u *uploadSessions) opLocs(session *uploadSession) []*pb.OperationLocations_OperationLocation {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]*pb.OperationLocations_OperationLocation{}, session.opLocs...)
+}
+
+// lookup returns the number of operations received for an upload by userId.
+func (u *uploadSessions) lookup(id string, userId string, projectId uint64) uint64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	session, found := u.sessions[id]
+	if !found || session.userId != userId || session.projectId != projectId {
+		return 0
+	}
+	return uint64(len(session.opLocs))
+}
+
+// abandonUpload removes an upload that will never be accepted and releases the chunks
+// of the operations it wrote.
+func (s JamsyncServer) abandonUpload(session *uploadSession) error {
+	s.uploads.remove(session)
+	return s.releaseUploadedOperations(session.projectId, session.ownerId, session.changeId, session.pathHash, s.uploads.opLocs(session))
+}
+
+// expireUploads abandons the uploads that were not resumed in time.
+func (s JamsyncServer) expireUploads() {
+	for _, session := range s.uploads.expire(time.Now()) {
+		err := s.abandonUpload(session)
+		if err != nil {
+			log.Printf("could not release the operations of expired upload %s: %v", session.id, err)
+		}
+	}
+}
+
+// releaseUploadedOperations releases the chunks of operations written for a file in a
+// change that were never recorded in the OpLocStore. The operations themselves stay in
+// the file's data until the change is aborted or compacted away.
+func (s JamsyncServer) releaseUploadedOperations(projectId uint64, ownerId string, changeId uint64, pathHash uint64, opLocs []*pb.OperationLocations_OperationLocation) error {
+	return s.releaseOperations(projectId, ownerId, &pb.OperationLocations{
+		ProjectId: projectId,
+		OwnerId:   ownerId,
+		ChangeId:  changeId,
+		PathHash:  pathHash,
+		OpLocs:    opLocs,
+		DataKey:   dataKey(pathHash, changeId),
+	})
+}
+
+func (s JamsyncServer) GetUploadStatus(ctx context.Context, in *pb.GetUploadStatusRequest) (*pb.GetUploadStatusResponse, error) {
+	userId, err := serverauth.ParseIdFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.authorizeProject(in.GetProjectId(), userId, db.RoleWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUploadStatusResponse{
+		ReceivedOps: s.uploads.lookup(in.GetUploadId(), userId, in.GetProjectId()),
+	}, nil
+}
+
+// verifyUpload checks that the operations of an upload produce content with the hash
+// that the client sent.
+func (s JamsyncServer) verifyUpload(projectId uint64, ownerId string, changeId uint64, pathHash uint64, opLocs []*pb.OperationLocations_OperationLocation, contentHash uint64) error {
+	if changeId == 0 {
+		return status.Errorf(codes.InvalidArgument, "change id is required")
+	}
+	// Uploads are deltas against the file before the change, see Client.UploadFile.
+	base, err := s.regenBase(projectId, ownerId, pathHash, changeId)
+	if err != nil {
+		return err
+	}
+	target, err := io.ReadAll(base)
+	if err != nil {
+		return err
+	}
+
+	content, err := s.applyOperations(target, projectId, ownerId, changeId, dataKey(pathHash, changeId), opLocs)
+	if err != nil {
+		return err
+	}
+	if xxhash.Sum64(content) != contentHash {
+		return status.Errorf(codes.DataLoss, "uploaded content does not match hash %d", contentHash)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -45,7 +46,9 @@ func UserProjectsHandler() gin.HandlerFunc {
 		}
 		defer closer()
 
-		resp, err := tempClient.ListUserProjects(ctx, &pb.ListUserProjectsRequest{})
+		resp, err := tempClient.ListUserProjects(ctx, &pb.ListUserProjectsRequest{
+			IncludeArchived: ctx.Query("archived") == "true",
+		})
 		if err != nil {
 			ctx.String(http.StatusInternalServerError, err.Error())
 			return
@@ -54,6 +57,98 @@ func UserProjectsHandler() gin.HandlerFunc {
 	}
 }
 
+// RenameProjectHandler renames a project to the name form value.
+func RenameProjectHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken := sessions.Default(ctx).Get("access_token").(string)
+		tempClient, closer, err := server.Connect(&oauth2.Token{AccessToken: accessToken})
+		if err != nil {
+			ctx.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer closer()
+
+		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
+			ProjectName: ctx.Param("projectName"),
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+
+		_, err = tempClient.RenameProject(ctx, &pb.RenameProjectRequest{
+			ProjectId:   config.GetProjectId(),
+			ProjectName: ctx.PostForm("name"),
+		})
+		if err != nil {
+			ctx.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// ArchiveProjectHandler archives a project, or unarchives it when the archived form
+// value is false.
+func ArchiveProjectHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken := sessions.Default(ctx).Get("access_token").(string)
+		tempClient, closer, err := server.Connect(&oauth2.Token{AccessToken: accessToken})
+		if err != nil {
+			ctx.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer closer()
+
+		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
+			ProjectName: ctx.Param("projectName"),
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+
+		_, err = tempClient.ArchiveProject(ctx, &pb.ArchiveProjectRequest{
+			ProjectId: config.GetProjectId(),
+			Archived:  ctx.PostForm("archived") != "false",
+		})
+		if err != nil {
+			ctx.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+func DeleteProjectHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken := sessions.Default(ctx).Get("access_token").(string)
+		tempClient, closer, err := server.Connect(&oauth2.Token{AccessToken: accessToken})
+		if err != nil {
+			ctx.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer closer()
+
+		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
+			ProjectName: ctx.Param("projectName"),
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+
+		_, err = tempClient.DeleteProject(ctx, &pb.DeleteProjectRequest{
+			ProjectId: config.GetProjectId(),
+		})
+		if err != nil {
+			ctx.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
 func ListCommittedChanges() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		accessToken := sessions.Default(ctx).Get("access_token").(string)
@@ -93,13 +188,13 @@ func ProjectBrowseHandler() gin.HandlerFunc {
 			return
 		}
 
-		changeId, err := strconv.Atoi(ctx.Query("commitId"))
+		changeId, err := resolveChange(ctx, tempClient, config.GetProjectId(), ctx.Query("commitId"))
 		if err != nil {
 			ctx.String(http.StatusBadRequest, err.Error())
 			return
 		}
 
-		client := client.NewClient(tempClient, config.GetProjectId(), uint64(changeId))
+		client := client.NewClient(tempClient, config.GetProjectId(), changeId)
 		resp, err := client.BrowseProject(ctx.Param("path")[1:])
 		if err != nil {
 			ctx.Error(err)
@@ -119,12 +214,51 @@ func GetFileHandler() gin.HandlerFunc {
 		}
 		defer closer()
 
-		changeId, err := strconv.Atoi(ctx.Query("commitId"))
+		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
+			ProjectName: ctx.Param("projectName"),
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+
+		changeId, err := resolveChange(ctx, tempClient, config.GetProjectId(), ctx.Query("commitId"))
 		if err != nil {
 			ctx.String(http.StatusBadRequest, err.Error())
 			return
 		}
 
+		client := client.NewClient(tempClient, config.GetProjectId(), changeId)
+
+		client.DownloadFile(ctx, ctx.Param("path")[1:], bytes.NewReader([]byte{}), ctx.Writer)
+	}
+}
+
+// ExportSnapshotHandler downloads an archive of the project at commitId, which may
+// also be a tag and defaults to the latest commit. format is tar.gz, the default, or
+// zip.
+func ExportSnapshotHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken := sessions.Default(ctx).Get("access_token").(string)
+		tempClient, closer, err := server.Connect(&oauth2.Token{AccessToken: accessToken})
+		if err != nil {
+			ctx.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer closer()
+
+		var format pb.ExportSnapshotRequest_Format
+		var contentType string
+		switch ctx.DefaultQuery("format", "tar.gz") {
+		case "tar.gz":
+			format, contentType = pb.ExportSnapshotRequest_TarGz, "application/gzip"
+		case "zip":
+			format, contentType = pb.ExportSnapshotRequest_Zip, "application/zip"
+		default:
+			ctx.String(http.StatusBadRequest, "format must be tar.gz or zip")
+			return
+		}
+
 		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
 			ProjectName: ctx.Param("projectName"),
 		})
@@ -133,9 +267,93 @@ func GetFileHandler() gin.HandlerFunc {
 			return
 		}
 
-		client := client.NewClient(tempClient, config.GetProjectId(), uint64(changeId))
+		changeId := config.GetCurrentChange()
+		if commitId := ctx.Query("commitId"); commitId != "" {
+			changeId, err = resolveChange(ctx, tempClient, config.GetProjectId(), commitId)
+			if err != nil {
+				ctx.String(http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		ctx.Header("Content-Type", contentType)
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%d.%s", ctx.Param("projectName"), changeId, ctx.DefaultQuery("format", "tar.gz"))))
+		client := client.NewClient(tempClient, config.GetProjectId(), changeId)
+		err = client.ExportSnapshot(ctx, format, ctx.Writer)
+		if err != nil && !ctx.Writer.Written() {
+			// Nothing was sent yet, so the error can still replace the archive.
+			ctx.Writer.Header().Del("Content-Type")
+			ctx.Writer.Header().Del("Content-Disposition")
+			ctx.String(http.StatusInternalServerError, err.Error())
+		}
+	}
+}
 
-		client.DownloadFile(ctx, ctx.Param("path")[1:], bytes.NewReader([]byte{}), ctx.Writer)
+// resolveChange returns the change id that value stands for, which is either a change
+// id or the name of a tag.
+func resolveChange(ctx context.Context, apiClient pb.JamsyncAPIClient, projectId uint64, value string) (uint64, error) {
+	changeId, err := strconv.ParseUint(value, 10, 64)
+	if err == nil {
+		return changeId, nil
+	}
+	tag, err := apiClient.GetTag(ctx, &pb.GetTagRequest{
+		ProjectId: projectId,
+		Name:      value,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return tag.GetChangeId(), nil
+}
+
+// DiffFileHandler compares a file between the from and to commit ids or tags. A
+// missing to compares against the latest commit and a missing from against an empty
+// file.
+func DiffFileHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken := sessions.Default(ctx).Get("access_token").(string)
+		tempClient, closer, err := server.Connect(&oauth2.Token{AccessToken: accessToken})
+		if err != nil {
+			ctx.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		defer closer()
+
+		config, err := tempClient.GetProjectConfig(ctx, &pb.GetProjectConfigRequest{
+			ProjectName: ctx.Param("projectName"),
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+
+		var fromChange, toChange uint64
+		if from := ctx.Query("from"); from != "" {
+			fromChange, err = resolveChange(ctx, tempClient, config.GetProjectId(), from)
+			if err != nil {
+				ctx.String(http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if to := ctx.Query("to"); to != "" {
+			toChange, err = resolveChange(ctx, tempClient, config.GetProjectId(), to)
+			if err != nil {
+				ctx.String(http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		resp, err := tempClient.DiffFile(ctx, &pb.DiffFileRequest{
+			ProjectId:  config.GetProjectId(),
+			Path:       ctx.Param("path")[1:],
+			FromChange: fromChange,
+			ToChange:   toChange,
+		})
+		if err != nil {
+			ctx.Error(err)
+			return
+		}
+		ctx.JSON(200, resp)
 	}
 }
 
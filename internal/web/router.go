@@ -107,9 +107,14 @@ func New(auth *authenticator.Authenticator) *gin.Engine {
 	router.GET("/api/committedchanges/:projectName", api.ListCommittedChanges())
 	router.GET("/api/ws/committedchanges/:projectName", api.CommitChangeWSHandler())
 	router.GET("/api/projects/:projectName", api.ProjectBrowseHandler())
+	router.DELETE("/api/projects/:projectName", api.DeleteProjectHandler())
+	router.POST("/api/projects/:projectName/rename", api.RenameProjectHandler())
+	router.POST("/api/projects/:projectName/archived", api.ArchiveProjectHandler())
+	router.GET("/api/projects/:projectName/archive", api.ExportSnapshotHandler())
 	router.GET("/api/projects/:projectName/files/*path", api.ProjectBrowseHandler())
 	router.PUT("/api/projects/:projectName/file/*path", api.PutFileHandler())
 	router.GET("/api/projects/:projectName/file/*path", api.GetFileHandler())
+	router.GET("/api/projects/:projectName/diff/*path", api.DiffFileHandler())
 
 	router.POST("/:username/projects", middleware.IsAuthenticated, middleware.Reauthenticate, userprojects.CreateHandler)
 	router.GET("/:username/projects", middleware.IsAuthenticated, middleware.Reauthenticate, userprojects.Handler)